@@ -9,6 +9,7 @@ import (
 	"github.com/rahulSailesh-shah/converSense/pkg/config"
 	"github.com/rahulSailesh-shah/converSense/pkg/database"
 	"github.com/rahulSailesh-shah/converSense/pkg/inngest"
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
 )
 
 type App struct {
@@ -32,11 +33,18 @@ func NewApp(ctx context.Context, cfg *config.AppConfig) (*App, error) {
 	}
 
 	queries := repo.New(dbInstance)
-	inngest, err := inngest.NewInngest(&cfg.AWS, &cfg.Gemini, &cfg.OpenAI, queries)
+	models, err := llm.NewModels(ctx, cfg)
 	if err != nil {
 		return nil, err
 	}
-	services := service.NewService(dbInstance, queries, inngest, cfg)
+	inngest, err := inngest.NewInngest(&cfg.AWS, models.Summary, dbInstance, queries)
+	if err != nil {
+		return nil, err
+	}
+	services := service.NewService(dbInstance, queries, inngest, models, cfg)
+	if err := services.Meeting.ReconcileActiveMeetings(ctx); err != nil {
+		fmt.Println("Error reconciling active meetings:", err)
+	}
 
 	return &App{
 		Config:  cfg,
@@ -13,19 +13,29 @@ import (
 )
 
 const createAgent = `-- name: CreateAgent :one
-INSERT INTO agent (name, user_id, instructions)
-VALUES ($1, $2, $3)
-RETURNING id, name, user_id, instructions, created_at, updated_at
+INSERT INTO agent (name, user_id, org_id, instructions, tools, settings)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, name, user_id, instructions, created_at, updated_at, tools, settings, org_id
 `
 
 type CreateAgentParams struct {
-	Name         string `db:"name" json:"name"`
-	UserID       string `db:"user_id" json:"userId"`
-	Instructions string `db:"instructions" json:"instructions"`
+	Name         string    `db:"name" json:"name"`
+	UserID       string    `db:"user_id" json:"userId"`
+	OrgID        uuid.UUID `db:"org_id" json:"orgId"`
+	Instructions string    `db:"instructions" json:"instructions"`
+	Tools        []byte    `db:"tools" json:"tools"`
+	Settings     []byte    `db:"settings" json:"settings"`
 }
 
 func (q *Queries) CreateAgent(ctx context.Context, arg CreateAgentParams) (Agent, error) {
-	row := q.db.QueryRow(ctx, createAgent, arg.Name, arg.UserID, arg.Instructions)
+	row := q.db.QueryRow(ctx, createAgent,
+		arg.Name,
+		arg.UserID,
+		arg.OrgID,
+		arg.Instructions,
+		arg.Tools,
+		arg.Settings,
+	)
 	var i Agent
 	err := row.Scan(
 		&i.ID,
@@ -34,12 +44,17 @@ func (q *Queries) CreateAgent(ctx context.Context, arg CreateAgentParams) (Agent
 		&i.Instructions,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Tools,
+		&i.Settings,
+		&i.OrgID,
 	)
 	return i, err
 }
 
 const deleteAgent = `-- name: DeleteAgent :exec
-DELETE FROM agent WHERE id = $1 AND user_id = $2
+DELETE FROM agent
+WHERE id = $1
+    AND org_id IN (SELECT org_id FROM organization_member WHERE user_id = $2)
 `
 
 type DeleteAgentParams struct {
@@ -54,16 +69,19 @@ func (q *Queries) DeleteAgent(ctx context.Context, arg DeleteAgentParams) error
 
 const getAgent = `-- name: GetAgent :one
 SELECT
- a.id, a.name, a.user_id, a.instructions, a.created_at, a.updated_at,
- COALESCE(m.meeting_count, 0) AS meeting_count
+ a.id, a.name, a.user_id, a.instructions, a.created_at, a.updated_at, a.tools, a.settings, a.org_id,
+ COALESCE(m.meeting_count, 0) AS meeting_count,
+ om.role AS member_role
 FROM agent a
+JOIN organization_member om
+    ON om.org_id = a.org_id AND om.user_id = $2
 LEFT JOIN (
     SELECT agent_id, COUNT(*) AS meeting_count
     FROM meeting
     WHERE agent_id = $1
     GROUP BY agent_id
 ) m ON a.id = m.agent_id
-WHERE a.id = $1 AND a.user_id = $2
+WHERE a.id = $1
 `
 
 type GetAgentParams struct {
@@ -78,7 +96,11 @@ type GetAgentRow struct {
 	Instructions string    `db:"instructions" json:"instructions"`
 	CreatedAt    time.Time `db:"created_at" json:"createdAt"`
 	UpdatedAt    time.Time `db:"updated_at" json:"updatedAt"`
+	Tools        []byte    `db:"tools" json:"tools"`
+	Settings     []byte    `db:"settings" json:"settings"`
+	OrgID        uuid.UUID `db:"org_id" json:"orgId"`
 	MeetingCount int64     `db:"meeting_count" json:"meetingCount"`
+	MemberRole   string    `db:"member_role" json:"memberRole"`
 }
 
 func (q *Queries) GetAgent(ctx context.Context, arg GetAgentParams) (GetAgentRow, error) {
@@ -91,13 +113,17 @@ func (q *Queries) GetAgent(ctx context.Context, arg GetAgentParams) (GetAgentRow
 		&i.Instructions,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Tools,
+		&i.Settings,
+		&i.OrgID,
 		&i.MeetingCount,
+		&i.MemberRole,
 	)
 	return i, err
 }
 
 const getAgentByID = `-- name: GetAgentByID :one
-SELECT id, name, user_id, instructions, created_at, updated_at FROM agent WHERE id = $1
+SELECT id, name, user_id, instructions, created_at, updated_at, tools, settings, org_id FROM agent WHERE id = $1
 `
 
 func (q *Queries) GetAgentByID(ctx context.Context, id uuid.UUID) (Agent, error) {
@@ -110,6 +136,9 @@ func (q *Queries) GetAgentByID(ctx context.Context, id uuid.UUID) (Agent, error)
 		&i.Instructions,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Tools,
+		&i.Settings,
+		&i.OrgID,
 	)
 	return i, err
 }
@@ -120,30 +149,41 @@ SELECT
     a.name,
     a.instructions,
     a.user_id,
+    a.org_id,
+    a.tools,
+    a.settings,
     a.created_at,
     a.updated_at,
     COUNT(m.id) AS meeting_count,
     COUNT(*) OVER() AS total_count
 FROM agent a
 LEFT JOIN meeting m ON a.id = m.agent_id
-WHERE a.user_id = $1
-  AND ($2::text = '' OR a.name ILIKE '%' || $2 || '%')
+WHERE a.org_id = $1
+  AND EXISTS (
+    SELECT 1 FROM organization_member om
+    WHERE om.org_id = a.org_id AND om.user_id = $2
+  )
+  AND ($3::text = '' OR a.name ILIKE '%' || $3 || '%')
 GROUP BY
     a.id,
     a.name,
     a.instructions,
     a.user_id,
+    a.org_id,
+    a.tools,
+    a.settings,
     a.created_at,
     a.updated_at
 ORDER BY a.updated_at DESC
-LIMIT $3 OFFSET $4
+LIMIT $4 OFFSET $5
 `
 
 type GetAgentsParams struct {
-	UserID  string `db:"user_id" json:"userId"`
-	Column2 string `db:"column_2" json:"column2"`
-	Limit   int32  `db:"limit" json:"limit"`
-	Offset  int32  `db:"offset" json:"offset"`
+	OrgID   uuid.UUID `db:"org_id" json:"orgId"`
+	UserID  string    `db:"user_id" json:"userId"`
+	Column3 string    `db:"column_3" json:"column3"`
+	Limit   int32     `db:"limit" json:"limit"`
+	Offset  int32     `db:"offset" json:"offset"`
 }
 
 type GetAgentsRow struct {
@@ -151,6 +191,9 @@ type GetAgentsRow struct {
 	Name         string    `db:"name" json:"name"`
 	Instructions string    `db:"instructions" json:"instructions"`
 	UserID       string    `db:"user_id" json:"userId"`
+	OrgID        uuid.UUID `db:"org_id" json:"orgId"`
+	Tools        []byte    `db:"tools" json:"tools"`
+	Settings     []byte    `db:"settings" json:"settings"`
 	CreatedAt    time.Time `db:"created_at" json:"createdAt"`
 	UpdatedAt    time.Time `db:"updated_at" json:"updatedAt"`
 	MeetingCount int64     `db:"meeting_count" json:"meetingCount"`
@@ -159,8 +202,9 @@ type GetAgentsRow struct {
 
 func (q *Queries) GetAgents(ctx context.Context, arg GetAgentsParams) ([]GetAgentsRow, error) {
 	rows, err := q.db.Query(ctx, getAgents,
+		arg.OrgID,
 		arg.UserID,
-		arg.Column2,
+		arg.Column3,
 		arg.Limit,
 		arg.Offset,
 	)
@@ -176,6 +220,9 @@ func (q *Queries) GetAgents(ctx context.Context, arg GetAgentsParams) ([]GetAgen
 			&i.Name,
 			&i.Instructions,
 			&i.UserID,
+			&i.OrgID,
+			&i.Tools,
+			&i.Settings,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.MeetingCount,
@@ -191,21 +238,43 @@ func (q *Queries) GetAgents(ctx context.Context, arg GetAgentsParams) ([]GetAgen
 	return items, nil
 }
 
+const lockAgent = `-- name: LockAgent :exec
+SELECT id FROM agent
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockAgent(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, lockAgent, id)
+	return err
+}
+
 const updateAgent = `-- name: UpdateAgent :one
 UPDATE agent
-SET name = $2, instructions = $3, updated_at = NOW()
+SET name = $2, instructions = $3, tools = $4, settings = $5, updated_at = NOW()
 WHERE id = $1
-RETURNING id, name, user_id, instructions, created_at, updated_at
+    AND org_id IN (SELECT org_id FROM organization_member WHERE user_id = $6)
+RETURNING id, name, user_id, instructions, created_at, updated_at, tools, settings, org_id
 `
 
 type UpdateAgentParams struct {
 	ID           uuid.UUID `db:"id" json:"id"`
 	Name         string    `db:"name" json:"name"`
 	Instructions string    `db:"instructions" json:"instructions"`
+	Tools        []byte    `db:"tools" json:"tools"`
+	Settings     []byte    `db:"settings" json:"settings"`
+	UserID       string    `db:"user_id" json:"userId"`
 }
 
 func (q *Queries) UpdateAgent(ctx context.Context, arg UpdateAgentParams) (Agent, error) {
-	row := q.db.QueryRow(ctx, updateAgent, arg.ID, arg.Name, arg.Instructions)
+	row := q.db.QueryRow(ctx, updateAgent,
+		arg.ID,
+		arg.Name,
+		arg.Instructions,
+		arg.Tools,
+		arg.Settings,
+		arg.UserID,
+	)
 	var i Agent
 	err := row.Scan(
 		&i.ID,
@@ -214,6 +283,9 @@ func (q *Queries) UpdateAgent(ctx context.Context, arg UpdateAgentParams) (Agent
 		&i.Instructions,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Tools,
+		&i.Settings,
+		&i.OrgID,
 	)
 	return i, err
 }
@@ -51,12 +51,17 @@ func (q *Queries) CreateChatMessage(ctx context.Context, arg CreateChatMessagePa
 
 const getChatMessages = `-- name: GetChatMessages :many
 SELECT id, meeting_id, user_id, role, content, created_at FROM meeting_chat_messages
-WHERE meeting_id = $1
+WHERE meeting_id = $1 AND user_id = $2
 ORDER BY created_at ASC
 `
 
-func (q *Queries) GetChatMessages(ctx context.Context, meetingID uuid.UUID) ([]MeetingChatMessages, error) {
-	rows, err := q.db.Query(ctx, getChatMessages, meetingID)
+type GetChatMessagesParams struct {
+	MeetingID uuid.UUID `db:"meeting_id" json:"meetingId"`
+	UserID    string    `db:"user_id" json:"userId"`
+}
+
+func (q *Queries) GetChatMessages(ctx context.Context, arg GetChatMessagesParams) ([]MeetingChatMessages, error) {
+	rows, err := q.db.Query(ctx, getChatMessages, arg.MeetingID, arg.UserID)
 	if err != nil {
 		return nil, err
 	}
@@ -84,18 +89,19 @@ func (q *Queries) GetChatMessages(ctx context.Context, meetingID uuid.UUID) ([]M
 
 const getRecentChatMessages = `-- name: GetRecentChatMessages :many
 SELECT id, meeting_id, user_id, role, content, created_at FROM meeting_chat_messages
-WHERE meeting_id = $1
+WHERE meeting_id = $1 AND user_id = $2
 ORDER BY created_at DESC
-LIMIT $2
+LIMIT $3
 `
 
 type GetRecentChatMessagesParams struct {
 	MeetingID uuid.UUID `db:"meeting_id" json:"meetingId"`
+	UserID    string    `db:"user_id" json:"userId"`
 	Limit     int32     `db:"limit" json:"limit"`
 }
 
 func (q *Queries) GetRecentChatMessages(ctx context.Context, arg GetRecentChatMessagesParams) ([]MeetingChatMessages, error) {
-	rows, err := q.db.Query(ctx, getRecentChatMessages, arg.MeetingID, arg.Limit)
+	rows, err := q.db.Query(ctx, getRecentChatMessages, arg.MeetingID, arg.UserID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
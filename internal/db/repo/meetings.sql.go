@@ -12,20 +12,72 @@ import (
 	"github.com/google/uuid"
 )
 
+const completeMeeting = `-- name: CompleteMeeting :one
+UPDATE meeting
+SET
+    status = 'completed',
+    end_time = $2,
+    transcript_url = COALESCE($3, transcript_url),
+    recording_url = COALESCE($4, recording_url),
+    updated_at = NOW()
+WHERE id = $1
+RETURNING id, name, user_id, agent_id, start_time, end_time, status, transcript_url, recording_url, summary, created_at, updated_at, agent_version_id, org_id
+`
+
+type CompleteMeetingParams struct {
+	ID            uuid.UUID  `db:"id" json:"id"`
+	EndTime       *time.Time `db:"end_time" json:"endTime"`
+	TranscriptUrl *string    `db:"transcript_url" json:"transcriptUrl"`
+	RecordingUrl  *string    `db:"recording_url" json:"recordingUrl"`
+}
+
+func (q *Queries) CompleteMeeting(ctx context.Context, arg CompleteMeetingParams) (Meeting, error) {
+	row := q.db.QueryRow(ctx, completeMeeting,
+		arg.ID,
+		arg.EndTime,
+		arg.TranscriptUrl,
+		arg.RecordingUrl,
+	)
+	var i Meeting
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.UserID,
+		&i.AgentID,
+		&i.StartTime,
+		&i.EndTime,
+		&i.Status,
+		&i.TranscriptUrl,
+		&i.RecordingUrl,
+		&i.Summary,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.AgentVersionID,
+		&i.OrgID,
+	)
+	return i, err
+}
+
 const createMeeting = `-- name: CreateMeeting :one
-INSERT INTO meeting (name, user_id, agent_id)
-VALUES ($1, $2, $3)
-RETURNING id, name, user_id, agent_id, start_time, end_time, status, transcript_url, recording_url, summary, created_at, updated_at
+INSERT INTO meeting (name, user_id, agent_id, org_id)
+VALUES ($1, $2, $3, $4)
+RETURNING id, name, user_id, agent_id, start_time, end_time, status, transcript_url, recording_url, summary, created_at, updated_at, agent_version_id, org_id
 `
 
 type CreateMeetingParams struct {
 	Name    string    `db:"name" json:"name"`
 	UserID  string    `db:"user_id" json:"userId"`
 	AgentID uuid.UUID `db:"agent_id" json:"agentId"`
+	OrgID   uuid.UUID `db:"org_id" json:"orgId"`
 }
 
 func (q *Queries) CreateMeeting(ctx context.Context, arg CreateMeetingParams) (Meeting, error) {
-	row := q.db.QueryRow(ctx, createMeeting, arg.Name, arg.UserID, arg.AgentID)
+	row := q.db.QueryRow(ctx, createMeeting,
+		arg.Name,
+		arg.UserID,
+		arg.AgentID,
+		arg.OrgID,
+	)
 	var i Meeting
 	err := row.Scan(
 		&i.ID,
@@ -40,25 +92,39 @@ func (q *Queries) CreateMeeting(ctx context.Context, arg CreateMeetingParams) (M
 		&i.Summary,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AgentVersionID,
+		&i.OrgID,
 	)
 	return i, err
 }
 
 const deleteMeeting = `-- name: DeleteMeeting :exec
-DELETE FROM meeting WHERE id = $1
+DELETE FROM meeting
+WHERE id = $1
+    AND org_id IN (SELECT org_id FROM organization_member WHERE user_id = $2)
 `
 
-func (q *Queries) DeleteMeeting(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, deleteMeeting, id)
+type DeleteMeetingParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	UserID string    `db:"user_id" json:"userId"`
+}
+
+func (q *Queries) DeleteMeeting(ctx context.Context, arg DeleteMeetingParams) error {
+	_, err := q.db.Exec(ctx, deleteMeeting, arg.ID, arg.UserID)
 	return err
 }
 
 const deleteMeetingsByUserID = `-- name: DeleteMeetingsByUserID :exec
-DELETE FROM meeting WHERE user_id = $1
+DELETE FROM meeting WHERE user_id = $1 AND org_id = $2
 `
 
-func (q *Queries) DeleteMeetingsByUserID(ctx context.Context, userID string) error {
-	_, err := q.db.Exec(ctx, deleteMeetingsByUserID, userID)
+type DeleteMeetingsByUserIDParams struct {
+	UserID string    `db:"user_id" json:"userId"`
+	OrgID  uuid.UUID `db:"org_id" json:"orgId"`
+}
+
+func (q *Queries) DeleteMeetingsByUserID(ctx context.Context, arg DeleteMeetingsByUserIDParams) error {
+	_, err := q.db.Exec(ctx, deleteMeetingsByUserID, arg.UserID, arg.OrgID)
 	return err
 }
 
@@ -67,6 +133,7 @@ SELECT
     m.id,
     m.name,
     m.user_id,
+    m.org_id,
     m.agent_id,
     m.start_time,
     m.end_time,
@@ -76,13 +143,24 @@ SELECT
     m.transcript_url,
     m.recording_url,
     m.summary,
+    m.agent_version_id,
     a.name AS agent_name,
-    a.instructions AS agent_instructions
+    a.instructions AS agent_instructions,
+    a.tools AS agent_tools,
+    a.settings AS agent_settings,
+    om.role AS member_role,
+    mp.status AS processing_status,
+    mp.step AS processing_step,
+    mp.error AS processing_error,
+    mp.attempts AS processing_attempts
 FROM meeting AS m
 JOIN agent AS a
     ON m.agent_id = a.id
+JOIN organization_member AS om
+    ON om.org_id = m.org_id AND om.user_id = $2
+LEFT JOIN meeting_processing AS mp
+    ON mp.meeting_id = m.id
 WHERE m.id = $1
-    AND m.user_id = $2
 `
 
 type GetMeetingParams struct {
@@ -91,20 +169,29 @@ type GetMeetingParams struct {
 }
 
 type GetMeetingRow struct {
-	ID                uuid.UUID  `db:"id" json:"id"`
-	Name              string     `db:"name" json:"name"`
-	UserID            string     `db:"user_id" json:"userId"`
-	AgentID           uuid.UUID  `db:"agent_id" json:"agentId"`
-	StartTime         *time.Time `db:"start_time" json:"startTime"`
-	EndTime           *time.Time `db:"end_time" json:"endTime"`
-	Status            string     `db:"status" json:"status"`
-	CreatedAt         time.Time  `db:"created_at" json:"createdAt"`
-	UpdatedAt         time.Time  `db:"updated_at" json:"updatedAt"`
-	TranscriptUrl     *string    `db:"transcript_url" json:"transcriptUrl"`
-	RecordingUrl      *string    `db:"recording_url" json:"recordingUrl"`
-	Summary           *string    `db:"summary" json:"summary"`
-	AgentName         string     `db:"agent_name" json:"agentName"`
-	AgentInstructions string     `db:"agent_instructions" json:"agentInstructions"`
+	ID                 uuid.UUID  `db:"id" json:"id"`
+	Name               string     `db:"name" json:"name"`
+	UserID             string     `db:"user_id" json:"userId"`
+	OrgID              uuid.UUID  `db:"org_id" json:"orgId"`
+	AgentID            uuid.UUID  `db:"agent_id" json:"agentId"`
+	StartTime          *time.Time `db:"start_time" json:"startTime"`
+	EndTime            *time.Time `db:"end_time" json:"endTime"`
+	Status             string     `db:"status" json:"status"`
+	CreatedAt          time.Time  `db:"created_at" json:"createdAt"`
+	UpdatedAt          time.Time  `db:"updated_at" json:"updatedAt"`
+	TranscriptUrl      *string    `db:"transcript_url" json:"transcriptUrl"`
+	RecordingUrl       *string    `db:"recording_url" json:"recordingUrl"`
+	Summary            *string    `db:"summary" json:"summary"`
+	AgentVersionID     *uuid.UUID `db:"agent_version_id" json:"agentVersionId"`
+	AgentName          string     `db:"agent_name" json:"agentName"`
+	AgentInstructions  string     `db:"agent_instructions" json:"agentInstructions"`
+	AgentTools         []byte     `db:"agent_tools" json:"agentTools"`
+	AgentSettings      []byte     `db:"agent_settings" json:"agentSettings"`
+	MemberRole         string     `db:"member_role" json:"memberRole"`
+	ProcessingStatus   *string    `db:"processing_status" json:"processingStatus"`
+	ProcessingStep     *string    `db:"processing_step" json:"processingStep"`
+	ProcessingError    *string    `db:"processing_error" json:"processingError"`
+	ProcessingAttempts *int32     `db:"processing_attempts" json:"processingAttempts"`
 }
 
 func (q *Queries) GetMeeting(ctx context.Context, arg GetMeetingParams) (GetMeetingRow, error) {
@@ -114,6 +201,7 @@ func (q *Queries) GetMeeting(ctx context.Context, arg GetMeetingParams) (GetMeet
 		&i.ID,
 		&i.Name,
 		&i.UserID,
+		&i.OrgID,
 		&i.AgentID,
 		&i.StartTime,
 		&i.EndTime,
@@ -123,18 +211,36 @@ func (q *Queries) GetMeeting(ctx context.Context, arg GetMeetingParams) (GetMeet
 		&i.TranscriptUrl,
 		&i.RecordingUrl,
 		&i.Summary,
+		&i.AgentVersionID,
 		&i.AgentName,
 		&i.AgentInstructions,
+		&i.AgentTools,
+		&i.AgentSettings,
+		&i.MemberRole,
+		&i.ProcessingStatus,
+		&i.ProcessingStep,
+		&i.ProcessingError,
+		&i.ProcessingAttempts,
 	)
 	return i, err
 }
 
 const getMeetingByID = `-- name: GetMeetingByID :one
-SELECT id, name, user_id, agent_id, start_time, end_time, status, transcript_url, recording_url, summary, created_at, updated_at FROM meeting WHERE id = $1
+SELECT id, name, user_id, agent_id, start_time, end_time, status, transcript_url, recording_url, summary, created_at, updated_at, agent_version_id, org_id FROM meeting
+WHERE id = $1
+    AND (
+        org_id IN (SELECT org_id FROM organization_member WHERE user_id = $2)
+        OR id IN (SELECT meeting_id FROM meeting_participant WHERE user_id = $2)
+    )
 `
 
-func (q *Queries) GetMeetingByID(ctx context.Context, id uuid.UUID) (Meeting, error) {
-	row := q.db.QueryRow(ctx, getMeetingByID, id)
+type GetMeetingByIDParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	UserID string    `db:"user_id" json:"userId"`
+}
+
+func (q *Queries) GetMeetingByID(ctx context.Context, arg GetMeetingByIDParams) (Meeting, error) {
+	row := q.db.QueryRow(ctx, getMeetingByID, arg.ID, arg.UserID)
 	var i Meeting
 	err := row.Scan(
 		&i.ID,
@@ -149,6 +255,81 @@ func (q *Queries) GetMeetingByID(ctx context.Context, id uuid.UUID) (Meeting, er
 		&i.Summary,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AgentVersionID,
+		&i.OrgID,
+	)
+	return i, err
+}
+
+const getMeetingDetails = `-- name: GetMeetingDetails :one
+SELECT
+    m.id,
+    m.name,
+    m.user_id,
+    m.org_id,
+    m.agent_id,
+    m.start_time,
+    m.end_time,
+    m.status,
+    m.created_at,
+    m.updated_at,
+    m.transcript_url,
+    m.recording_url,
+    m.summary,
+    m.agent_version_id,
+    a.name AS agent_name,
+    a.instructions AS agent_instructions,
+    a.tools AS agent_tools,
+    a.settings AS agent_settings
+FROM meeting AS m
+JOIN agent AS a
+    ON m.agent_id = a.id
+WHERE m.id = $1
+`
+
+type GetMeetingDetailsRow struct {
+	ID                uuid.UUID  `db:"id" json:"id"`
+	Name              string     `db:"name" json:"name"`
+	UserID            string     `db:"user_id" json:"userId"`
+	OrgID             uuid.UUID  `db:"org_id" json:"orgId"`
+	AgentID           uuid.UUID  `db:"agent_id" json:"agentId"`
+	StartTime         *time.Time `db:"start_time" json:"startTime"`
+	EndTime           *time.Time `db:"end_time" json:"endTime"`
+	Status            string     `db:"status" json:"status"`
+	CreatedAt         time.Time  `db:"created_at" json:"createdAt"`
+	UpdatedAt         time.Time  `db:"updated_at" json:"updatedAt"`
+	TranscriptUrl     *string    `db:"transcript_url" json:"transcriptUrl"`
+	RecordingUrl      *string    `db:"recording_url" json:"recordingUrl"`
+	Summary           *string    `db:"summary" json:"summary"`
+	AgentVersionID    *uuid.UUID `db:"agent_version_id" json:"agentVersionId"`
+	AgentName         string     `db:"agent_name" json:"agentName"`
+	AgentInstructions string     `db:"agent_instructions" json:"agentInstructions"`
+	AgentTools        []byte     `db:"agent_tools" json:"agentTools"`
+	AgentSettings     []byte     `db:"agent_settings" json:"agentSettings"`
+}
+
+func (q *Queries) GetMeetingDetails(ctx context.Context, id uuid.UUID) (GetMeetingDetailsRow, error) {
+	row := q.db.QueryRow(ctx, getMeetingDetails, id)
+	var i GetMeetingDetailsRow
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.UserID,
+		&i.OrgID,
+		&i.AgentID,
+		&i.StartTime,
+		&i.EndTime,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.TranscriptUrl,
+		&i.RecordingUrl,
+		&i.Summary,
+		&i.AgentVersionID,
+		&i.AgentName,
+		&i.AgentInstructions,
+		&i.AgentTools,
+		&i.AgentSettings,
 	)
 	return i, err
 }
@@ -158,6 +339,7 @@ SELECT
     m.id,
     m.name,
     m.user_id,
+    m.org_id,
     m.agent_id,
     m.start_time,
     m.end_time,
@@ -166,47 +348,64 @@ SELECT
     m.updated_at,
     COUNT(*) OVER() as total_count,
     a.name AS agent_name,
-    a.instructions AS agent_instructions
+    a.instructions AS agent_instructions,
+    mp.status AS processing_status,
+    mp.step AS processing_step,
+    mp.error AS processing_error,
+    mp.attempts AS processing_attempts
 FROM meeting AS m
 JOIN agent AS a
     ON m.agent_id = a.id
-WHERE m.user_id = $1
+LEFT JOIN meeting_processing AS mp
+    ON mp.meeting_id = m.id
+WHERE m.org_id = $1
+    AND EXISTS (
+        SELECT 1 FROM organization_member om
+        WHERE om.org_id = m.org_id AND om.user_id = $2
+    )
     AND (
         CASE
-            WHEN $2::text != '' THEN m.name ILIKE '%' || $2 || '%'
+            WHEN $3::text != '' THEN m.name ILIKE '%' || $3 || '%'
             ELSE TRUE
         END
     )
 ORDER BY m.updated_at DESC
-LIMIT $3 OFFSET $4
+LIMIT $4 OFFSET $5
 `
 
 type GetMeetingsParams struct {
-	UserID  string `db:"user_id" json:"userId"`
-	Column2 string `db:"column_2" json:"column2"`
-	Limit   int32  `db:"limit" json:"limit"`
-	Offset  int32  `db:"offset" json:"offset"`
+	OrgID   uuid.UUID `db:"org_id" json:"orgId"`
+	UserID  string    `db:"user_id" json:"userId"`
+	Column3 string    `db:"column_3" json:"column3"`
+	Limit   int32     `db:"limit" json:"limit"`
+	Offset  int32     `db:"offset" json:"offset"`
 }
 
 type GetMeetingsRow struct {
-	ID                uuid.UUID  `db:"id" json:"id"`
-	Name              string     `db:"name" json:"name"`
-	UserID            string     `db:"user_id" json:"userId"`
-	AgentID           uuid.UUID  `db:"agent_id" json:"agentId"`
-	StartTime         *time.Time `db:"start_time" json:"startTime"`
-	EndTime           *time.Time `db:"end_time" json:"endTime"`
-	Status            string     `db:"status" json:"status"`
-	CreatedAt         time.Time  `db:"created_at" json:"createdAt"`
-	UpdatedAt         time.Time  `db:"updated_at" json:"updatedAt"`
-	TotalCount        int64      `db:"total_count" json:"totalCount"`
-	AgentName         string     `db:"agent_name" json:"agentName"`
-	AgentInstructions string     `db:"agent_instructions" json:"agentInstructions"`
+	ID                 uuid.UUID  `db:"id" json:"id"`
+	Name               string     `db:"name" json:"name"`
+	UserID             string     `db:"user_id" json:"userId"`
+	OrgID              uuid.UUID  `db:"org_id" json:"orgId"`
+	AgentID            uuid.UUID  `db:"agent_id" json:"agentId"`
+	StartTime          *time.Time `db:"start_time" json:"startTime"`
+	EndTime            *time.Time `db:"end_time" json:"endTime"`
+	Status             string     `db:"status" json:"status"`
+	CreatedAt          time.Time  `db:"created_at" json:"createdAt"`
+	UpdatedAt          time.Time  `db:"updated_at" json:"updatedAt"`
+	TotalCount         int64      `db:"total_count" json:"totalCount"`
+	AgentName          string     `db:"agent_name" json:"agentName"`
+	AgentInstructions  string     `db:"agent_instructions" json:"agentInstructions"`
+	ProcessingStatus   *string    `db:"processing_status" json:"processingStatus"`
+	ProcessingStep     *string    `db:"processing_step" json:"processingStep"`
+	ProcessingError    *string    `db:"processing_error" json:"processingError"`
+	ProcessingAttempts *int32     `db:"processing_attempts" json:"processingAttempts"`
 }
 
 func (q *Queries) GetMeetings(ctx context.Context, arg GetMeetingsParams) ([]GetMeetingsRow, error) {
 	rows, err := q.db.Query(ctx, getMeetings,
+		arg.OrgID,
 		arg.UserID,
-		arg.Column2,
+		arg.Column3,
 		arg.Limit,
 		arg.Offset,
 	)
@@ -221,6 +420,7 @@ func (q *Queries) GetMeetings(ctx context.Context, arg GetMeetingsParams) ([]Get
 			&i.ID,
 			&i.Name,
 			&i.UserID,
+			&i.OrgID,
 			&i.AgentID,
 			&i.StartTime,
 			&i.EndTime,
@@ -230,6 +430,10 @@ func (q *Queries) GetMeetings(ctx context.Context, arg GetMeetingsParams) ([]Get
 			&i.TotalCount,
 			&i.AgentName,
 			&i.AgentInstructions,
+			&i.ProcessingStatus,
+			&i.ProcessingStep,
+			&i.ProcessingError,
+			&i.ProcessingAttempts,
 		); err != nil {
 			return nil, err
 		}
@@ -241,6 +445,107 @@ func (q *Queries) GetMeetings(ctx context.Context, arg GetMeetingsParams) ([]Get
 	return items, nil
 }
 
+const getMeetingsByStatus = `-- name: GetMeetingsByStatus :many
+SELECT id, name, user_id, agent_id, start_time, end_time, status, transcript_url, recording_url, summary, created_at, updated_at, agent_version_id, org_id FROM meeting WHERE status = $1
+`
+
+func (q *Queries) GetMeetingsByStatus(ctx context.Context, status string) ([]Meeting, error) {
+	rows, err := q.db.Query(ctx, getMeetingsByStatus, status)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Meeting{}
+	for rows.Next() {
+		var i Meeting
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.UserID,
+			&i.AgentID,
+			&i.StartTime,
+			&i.EndTime,
+			&i.Status,
+			&i.TranscriptUrl,
+			&i.RecordingUrl,
+			&i.Summary,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.AgentVersionID,
+			&i.OrgID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const searchMeetingSummaries = `-- name: SearchMeetingSummaries :many
+SELECT id, name, start_time, summary FROM meeting
+WHERE org_id = $1
+    AND status = 'completed'
+    AND summary IS NOT NULL
+    AND name ILIKE '%' || $2::text || '%'
+ORDER BY start_time DESC NULLS LAST
+LIMIT $3
+`
+
+type SearchMeetingSummariesParams struct {
+	OrgID       uuid.UUID `db:"org_id" json:"orgId"`
+	Query       string    `db:"query" json:"query"`
+	ResultLimit int32     `db:"result_limit" json:"resultLimit"`
+}
+
+type SearchMeetingSummariesRow struct {
+	ID        uuid.UUID  `db:"id" json:"id"`
+	Name      string     `db:"name" json:"name"`
+	StartTime *time.Time `db:"start_time" json:"startTime"`
+	Summary   *string    `db:"summary" json:"summary"`
+}
+
+func (q *Queries) SearchMeetingSummaries(ctx context.Context, arg SearchMeetingSummariesParams) ([]SearchMeetingSummariesRow, error) {
+	rows, err := q.db.Query(ctx, searchMeetingSummaries, arg.OrgID, arg.Query, arg.ResultLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []SearchMeetingSummariesRow{}
+	for rows.Next() {
+		var i SearchMeetingSummariesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.StartTime,
+			&i.Summary,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const setMeetingAgentVersion = `-- name: SetMeetingAgentVersion :exec
+UPDATE meeting SET agent_version_id = $2 WHERE id = $1
+`
+
+type SetMeetingAgentVersionParams struct {
+	ID             uuid.UUID  `db:"id" json:"id"`
+	AgentVersionID *uuid.UUID `db:"agent_version_id" json:"agentVersionId"`
+}
+
+func (q *Queries) SetMeetingAgentVersion(ctx context.Context, arg SetMeetingAgentVersionParams) error {
+	_, err := q.db.Exec(ctx, setMeetingAgentVersion, arg.ID, arg.AgentVersionID)
+	return err
+}
+
 const updateMeeting = `-- name: UpdateMeeting :one
 UPDATE meeting
 SET
@@ -253,8 +558,9 @@ SET
     recording_url = COALESCE($9, recording_url),
     summary = COALESCE($10, summary),
     updated_at = NOW()
-WHERE id = $1 AND user_id = $2
-RETURNING id, name, user_id, agent_id, start_time, end_time, status, transcript_url, recording_url, summary, created_at, updated_at
+WHERE id = $1
+    AND org_id IN (SELECT org_id FROM organization_member WHERE user_id = $2)
+RETURNING id, name, user_id, agent_id, start_time, end_time, status, transcript_url, recording_url, summary, created_at, updated_at, agent_version_id, org_id
 `
 
 type UpdateMeetingParams struct {
@@ -297,6 +603,8 @@ func (q *Queries) UpdateMeeting(ctx context.Context, arg UpdateMeetingParams) (M
 		&i.Summary,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.AgentVersionID,
+		&i.OrgID,
 	)
 	return i, err
 }
@@ -18,21 +18,85 @@ type Agent struct {
 	Instructions string    `db:"instructions" json:"instructions"`
 	CreatedAt    time.Time `db:"created_at" json:"createdAt"`
 	UpdatedAt    time.Time `db:"updated_at" json:"updatedAt"`
+	Tools        []byte    `db:"tools" json:"tools"`
+	Settings     []byte    `db:"settings" json:"settings"`
+	OrgID        uuid.UUID `db:"org_id" json:"orgId"`
+}
+
+type AgentDocument struct {
+	ID          uuid.UUID `db:"id" json:"id"`
+	AgentID     uuid.UUID `db:"agent_id" json:"agentId"`
+	Name        string    `db:"name" json:"name"`
+	ContentType string    `db:"content_type" json:"contentType"`
+	SizeBytes   int64     `db:"size_bytes" json:"sizeBytes"`
+	ChunkCount  int32     `db:"chunk_count" json:"chunkCount"`
+	CreatedAt   time.Time `db:"created_at" json:"createdAt"`
+}
+
+type AgentDocumentChunk struct {
+	ID         uuid.UUID `db:"id" json:"id"`
+	DocumentID uuid.UUID `db:"document_id" json:"documentId"`
+	AgentID    uuid.UUID `db:"agent_id" json:"agentId"`
+	ChunkIndex int32     `db:"chunk_index" json:"chunkIndex"`
+	Content    string    `db:"content" json:"content"`
+	Embedding  string    `db:"embedding" json:"embedding"`
+	CreatedAt  time.Time `db:"created_at" json:"createdAt"`
+}
+
+type AgentVersion struct {
+	ID             uuid.UUID `db:"id" json:"id"`
+	AgentID        uuid.UUID `db:"agent_id" json:"agentId"`
+	Version        int32     `db:"version" json:"version"`
+	Name           string    `db:"name" json:"name"`
+	Instructions   string    `db:"instructions" json:"instructions"`
+	Tools          []byte    `db:"tools" json:"tools"`
+	Settings       []byte    `db:"settings" json:"settings"`
+	RolledBackFrom *int32    `db:"rolled_back_from" json:"rolledBackFrom"`
+	CreatedAt      time.Time `db:"created_at" json:"createdAt"`
+}
+
+type ApiKey struct {
+	ID         uuid.UUID  `db:"id" json:"id"`
+	UserID     string     `db:"user_id" json:"userId"`
+	Name       string     `db:"name" json:"name"`
+	Prefix     string     `db:"prefix" json:"prefix"`
+	KeyHash    string     `db:"key_hash" json:"keyHash"`
+	Scopes     []string   `db:"scopes" json:"scopes"`
+	ExpiresAt  *time.Time `db:"expires_at" json:"expiresAt"`
+	LastUsedAt *time.Time `db:"last_used_at" json:"lastUsedAt"`
+	RevokedAt  *time.Time `db:"revoked_at" json:"revokedAt"`
+	CreatedAt  time.Time  `db:"created_at" json:"createdAt"`
 }
 
 type Meeting struct {
-	ID            uuid.UUID  `db:"id" json:"id"`
-	Name          string     `db:"name" json:"name"`
-	UserID        string     `db:"user_id" json:"userId"`
-	AgentID       uuid.UUID  `db:"agent_id" json:"agentId"`
-	StartTime     *time.Time `db:"start_time" json:"startTime"`
-	EndTime       *time.Time `db:"end_time" json:"endTime"`
-	Status        string     `db:"status" json:"status"`
-	TranscriptUrl *string    `db:"transcript_url" json:"transcriptUrl"`
-	RecordingUrl  *string    `db:"recording_url" json:"recordingUrl"`
-	Summary       *string    `db:"summary" json:"summary"`
-	CreatedAt     time.Time  `db:"created_at" json:"createdAt"`
-	UpdatedAt     time.Time  `db:"updated_at" json:"updatedAt"`
+	ID             uuid.UUID  `db:"id" json:"id"`
+	Name           string     `db:"name" json:"name"`
+	UserID         string     `db:"user_id" json:"userId"`
+	AgentID        uuid.UUID  `db:"agent_id" json:"agentId"`
+	StartTime      *time.Time `db:"start_time" json:"startTime"`
+	EndTime        *time.Time `db:"end_time" json:"endTime"`
+	Status         string     `db:"status" json:"status"`
+	TranscriptUrl  *string    `db:"transcript_url" json:"transcriptUrl"`
+	RecordingUrl   *string    `db:"recording_url" json:"recordingUrl"`
+	Summary        *string    `db:"summary" json:"summary"`
+	CreatedAt      time.Time  `db:"created_at" json:"createdAt"`
+	UpdatedAt      time.Time  `db:"updated_at" json:"updatedAt"`
+	AgentVersionID *uuid.UUID `db:"agent_version_id" json:"agentVersionId"`
+	OrgID          uuid.UUID  `db:"org_id" json:"orgId"`
+}
+
+type MeetingActionItem struct {
+	ID              uuid.UUID  `db:"id" json:"id"`
+	MeetingID       uuid.UUID  `db:"meeting_id" json:"meetingId"`
+	Description     string     `db:"description" json:"description"`
+	Owner           *string    `db:"owner" json:"owner"`
+	DueDate         *time.Time `db:"due_date" json:"dueDate"`
+	SourceTimestamp *time.Time `db:"source_timestamp" json:"sourceTimestamp"`
+	Source          string     `db:"source" json:"source"`
+	Status          string     `db:"status" json:"status"`
+	CreatedBy       *string    `db:"created_by" json:"createdBy"`
+	CreatedAt       time.Time  `db:"created_at" json:"createdAt"`
+	UpdatedAt       time.Time  `db:"updated_at" json:"updatedAt"`
 }
 
 type MeetingChatMessages struct {
@@ -44,6 +108,92 @@ type MeetingChatMessages struct {
 	CreatedAt pgtype.Timestamptz `db:"created_at" json:"createdAt"`
 }
 
+type MeetingDecision struct {
+	ID              uuid.UUID  `db:"id" json:"id"`
+	MeetingID       uuid.UUID  `db:"meeting_id" json:"meetingId"`
+	Description     string     `db:"description" json:"description"`
+	MadeBy          *string    `db:"made_by" json:"madeBy"`
+	SourceTimestamp *time.Time `db:"source_timestamp" json:"sourceTimestamp"`
+	CreatedAt       time.Time  `db:"created_at" json:"createdAt"`
+}
+
+type MeetingNote struct {
+	ID        uuid.UUID  `db:"id" json:"id"`
+	MeetingID uuid.UUID  `db:"meeting_id" json:"meetingId"`
+	Kind      string     `db:"kind" json:"kind"`
+	Content   string     `db:"content" json:"content"`
+	Author    *string    `db:"author" json:"author"`
+	RemindAt  *time.Time `db:"remind_at" json:"remindAt"`
+	CreatedAt time.Time  `db:"created_at" json:"createdAt"`
+}
+
+type MeetingParticipant struct {
+	ID        uuid.UUID `db:"id" json:"id"`
+	MeetingID uuid.UUID `db:"meeting_id" json:"meetingId"`
+	UserID    string    `db:"user_id" json:"userId"`
+	InvitedBy string    `db:"invited_by" json:"invitedBy"`
+	Status    string    `db:"status" json:"status"`
+	CreatedAt time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt time.Time `db:"updated_at" json:"updatedAt"`
+}
+
+type MeetingProcessing struct {
+	MeetingID uuid.UUID `db:"meeting_id" json:"meetingId"`
+	Status    string    `db:"status" json:"status"`
+	Step      *string   `db:"step" json:"step"`
+	Error     *string   `db:"error" json:"error"`
+	Attempts  int32     `db:"attempts" json:"attempts"`
+	UpdatedAt time.Time `db:"updated_at" json:"updatedAt"`
+}
+
+type MeetingQuestion struct {
+	ID              uuid.UUID  `db:"id" json:"id"`
+	MeetingID       uuid.UUID  `db:"meeting_id" json:"meetingId"`
+	Question        string     `db:"question" json:"question"`
+	AskedBy         *string    `db:"asked_by" json:"askedBy"`
+	SourceTimestamp *time.Time `db:"source_timestamp" json:"sourceTimestamp"`
+	CreatedAt       time.Time  `db:"created_at" json:"createdAt"`
+}
+
+type MeetingSentimentReport struct {
+	MeetingID    uuid.UUID `db:"meeting_id" json:"meetingId"`
+	Overall      string    `db:"overall" json:"overall"`
+	Score        float64   `db:"score" json:"score"`
+	Summary      string    `db:"summary" json:"summary"`
+	Participants []byte    `db:"participants" json:"participants"`
+	Model        *string   `db:"model" json:"model"`
+	CreatedAt    time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt    time.Time `db:"updated_at" json:"updatedAt"`
+}
+
+type MeetingSummary struct {
+	ID        uuid.UUID `db:"id" json:"id"`
+	MeetingID uuid.UUID `db:"meeting_id" json:"meetingId"`
+	Version   int32     `db:"version" json:"version"`
+	Content   string    `db:"content" json:"content"`
+	Template  *string   `db:"template" json:"template"`
+	Model     *string   `db:"model" json:"model"`
+	Active    bool      `db:"active" json:"active"`
+	CreatedBy *string   `db:"created_by" json:"createdBy"`
+	CreatedAt time.Time `db:"created_at" json:"createdAt"`
+}
+
+type Organization struct {
+	ID        uuid.UUID `db:"id" json:"id"`
+	Name      string    `db:"name" json:"name"`
+	CreatedBy string    `db:"created_by" json:"createdBy"`
+	CreatedAt time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt time.Time `db:"updated_at" json:"updatedAt"`
+}
+
+type OrganizationMember struct {
+	OrgID     uuid.UUID `db:"org_id" json:"orgId"`
+	UserID    string    `db:"user_id" json:"userId"`
+	Role      string    `db:"role" json:"role"`
+	CreatedAt time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt time.Time `db:"updated_at" json:"updatedAt"`
+}
+
 type User struct {
 	ID            string    `db:"id" json:"id"`
 	Name          string    `db:"name" json:"name"`
@@ -53,3 +203,30 @@ type User struct {
 	CreatedAt     time.Time `db:"created_at" json:"createdAt"`
 	UpdatedAt     time.Time `db:"updated_at" json:"updatedAt"`
 }
+
+type WebhookDelivery struct {
+	ID             uuid.UUID  `db:"id" json:"id"`
+	EndpointID     uuid.UUID  `db:"endpoint_id" json:"endpointId"`
+	Event          string     `db:"event" json:"event"`
+	Payload        []byte     `db:"payload" json:"payload"`
+	Status         string     `db:"status" json:"status"`
+	Attempts       int32      `db:"attempts" json:"attempts"`
+	ResponseStatus *int32     `db:"response_status" json:"responseStatus"`
+	LastError      *string    `db:"last_error" json:"lastError"`
+	ReplayOf       *uuid.UUID `db:"replay_of" json:"replayOf"`
+	DeliveredAt    *time.Time `db:"delivered_at" json:"deliveredAt"`
+	CreatedAt      time.Time  `db:"created_at" json:"createdAt"`
+	UpdatedAt      time.Time  `db:"updated_at" json:"updatedAt"`
+}
+
+type WebhookEndpoint struct {
+	ID        uuid.UUID `db:"id" json:"id"`
+	OrgID     uuid.UUID `db:"org_id" json:"orgId"`
+	Url       string    `db:"url" json:"url"`
+	Secret    string    `db:"secret" json:"secret"`
+	Events    []string  `db:"events" json:"events"`
+	Active    bool      `db:"active" json:"active"`
+	CreatedBy string    `db:"created_by" json:"createdBy"`
+	CreatedAt time.Time `db:"created_at" json:"createdAt"`
+	UpdatedAt time.Time `db:"updated_at" json:"updatedAt"`
+}
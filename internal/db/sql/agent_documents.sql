@@ -0,0 +1,31 @@
+-- name: CreateAgentDocument :one
+INSERT INTO agent_document (agent_id, name, content_type, size_bytes, chunk_count)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: CreateAgentDocumentChunk :exec
+INSERT INTO agent_document_chunk (document_id, agent_id, chunk_index, content, embedding)
+VALUES (@document_id, @agent_id, @chunk_index, @content, @embedding::text::vector);
+
+-- name: GetAgentDocuments :many
+SELECT * FROM agent_document
+WHERE agent_id = $1
+ORDER BY created_at DESC;
+
+-- name: DeleteAgentDocument :execrows
+DELETE FROM agent_document WHERE id = $1 AND agent_id = $2;
+
+-- name: SearchAgentDocumentChunks :many
+SELECT
+    c.id,
+    c.document_id,
+    d.name AS document_name,
+    c.chunk_index,
+    c.content,
+    (c.embedding <=> @embedding::text::vector)::float8 AS distance
+FROM agent_document_chunk AS c
+JOIN agent_document AS d
+    ON c.document_id = d.id
+WHERE c.agent_id = @agent_id
+ORDER BY c.embedding <=> @embedding::text::vector
+LIMIT @result_limit;
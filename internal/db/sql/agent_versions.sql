@@ -0,0 +1,30 @@
+-- name: CreateAgentVersion :one
+INSERT INTO agent_version (agent_id, version, name, instructions, tools, settings, rolled_back_from)
+VALUES (
+    @agent_id,
+    (SELECT COALESCE(MAX(version), 0) + 1 FROM agent_version WHERE agent_id = @agent_id),
+    @name,
+    @instructions,
+    @tools,
+    @settings,
+    @rolled_back_from
+)
+RETURNING *;
+
+-- name: GetAgentVersions :many
+SELECT * FROM agent_version
+WHERE agent_id = $1
+ORDER BY version DESC;
+
+-- name: GetAgentVersion :one
+SELECT * FROM agent_version
+WHERE agent_id = $1 AND version = $2;
+
+-- name: GetAgentVersionByID :one
+SELECT * FROM agent_version WHERE id = $1;
+
+-- name: GetLatestAgentVersion :one
+SELECT * FROM agent_version
+WHERE agent_id = $1
+ORDER BY version DESC
+LIMIT 1;
@@ -1,6 +1,6 @@
 -- name: CreateAgent :one
-INSERT INTO agent (name, user_id, instructions)
-VALUES ($1, $2, $3)
+INSERT INTO agent (name, user_id, org_id, instructions, tools, settings)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING *;
 
 -- name: GetAgentByID :one
@@ -12,43 +12,64 @@ SELECT
     a.name,
     a.instructions,
     a.user_id,
+    a.org_id,
+    a.tools,
+    a.settings,
     a.created_at,
     a.updated_at,
     COUNT(m.id) AS meeting_count,
     COUNT(*) OVER() AS total_count
 FROM agent a
 LEFT JOIN meeting m ON a.id = m.agent_id
-WHERE a.user_id = $1
-  AND ($2::text = '' OR a.name ILIKE '%' || $2 || '%')
+WHERE a.org_id = $1
+  AND EXISTS (
+    SELECT 1 FROM organization_member om
+    WHERE om.org_id = a.org_id AND om.user_id = $2
+  )
+  AND ($3::text = '' OR a.name ILIKE '%' || $3 || '%')
 GROUP BY
     a.id,
     a.name,
     a.instructions,
     a.user_id,
+    a.org_id,
+    a.tools,
+    a.settings,
     a.created_at,
     a.updated_at
 ORDER BY a.updated_at DESC
-LIMIT $3 OFFSET $4;
+LIMIT $4 OFFSET $5;
 
 
 -- name: GetAgent :one
 SELECT
  a.*,
- COALESCE(m.meeting_count, 0) AS meeting_count
+ COALESCE(m.meeting_count, 0) AS meeting_count,
+ om.role AS member_role
 FROM agent a
+JOIN organization_member om
+    ON om.org_id = a.org_id AND om.user_id = $2
 LEFT JOIN (
     SELECT agent_id, COUNT(*) AS meeting_count
     FROM meeting
     WHERE agent_id = $1
     GROUP BY agent_id
 ) m ON a.id = m.agent_id
-WHERE a.id = $1 AND a.user_id = $2;
+WHERE a.id = $1;
 
 -- name: UpdateAgent :one
 UPDATE agent
-SET name = $2, instructions = $3, updated_at = NOW()
+SET name = $2, instructions = $3, tools = $4, settings = $5, updated_at = NOW()
 WHERE id = $1
+    AND org_id IN (SELECT org_id FROM organization_member WHERE user_id = $6)
 RETURNING *;
 
+-- name: LockAgent :exec
+SELECT id FROM agent
+WHERE id = $1
+FOR UPDATE;
+
 -- name: DeleteAgent :exec
-DELETE FROM agent WHERE id = $1 AND user_id = $2 ;
+DELETE FROM agent
+WHERE id = $1
+    AND org_id IN (SELECT org_id FROM organization_member WHERE user_id = $2);
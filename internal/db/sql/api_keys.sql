@@ -0,0 +1,21 @@
+-- name: CreateAPIKey :one
+INSERT INTO api_key (user_id, name, prefix, key_hash, scopes, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: GetAPIKeyByPrefix :one
+SELECT * FROM api_key
+WHERE prefix = $1 AND revoked_at IS NULL;
+
+-- name: GetAPIKeys :many
+SELECT * FROM api_key
+WHERE user_id = $1
+ORDER BY created_at DESC;
+
+-- name: RevokeAPIKey :execrows
+UPDATE api_key
+SET revoked_at = NOW()
+WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL;
+
+-- name: TouchAPIKey :exec
+UPDATE api_key SET last_used_at = NOW() WHERE id = $1;
@@ -11,11 +11,11 @@ RETURNING *;
 
 -- name: GetChatMessages :many
 SELECT * FROM meeting_chat_messages
-WHERE meeting_id = $1
+WHERE meeting_id = $1 AND user_id = $2
 ORDER BY created_at ASC;
 
 -- name: GetRecentChatMessages :many
 SELECT * FROM meeting_chat_messages
-WHERE meeting_id = $1
+WHERE meeting_id = $1 AND user_id = $2
 ORDER BY created_at DESC
-LIMIT $2;
+LIMIT $3;
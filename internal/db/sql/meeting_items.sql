@@ -0,0 +1,59 @@
+-- name: CreateMeetingActionItem :one
+INSERT INTO meeting_action_item (meeting_id, description, owner, due_date, source_timestamp, source, created_by)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetMeetingActionItems :many
+SELECT * FROM meeting_action_item
+WHERE meeting_id = $1
+ORDER BY created_at ASC;
+
+-- name: CreateMeetingNote :one
+INSERT INTO meeting_note (meeting_id, kind, content, author, remind_at)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: GetMeetingNote :one
+SELECT * FROM meeting_note
+WHERE id = $1;
+
+-- name: GetMeetingNotes :many
+SELECT * FROM meeting_note
+WHERE meeting_id = $1
+ORDER BY created_at ASC;
+
+-- name: UpdateMeetingActionItemStatus :one
+UPDATE meeting_action_item
+SET status = $3, updated_at = NOW()
+WHERE id = $1 AND meeting_id = $2
+RETURNING *;
+
+-- name: DeleteOpenMeetingActionItemsBySource :exec
+DELETE FROM meeting_action_item
+WHERE meeting_id = $1 AND source = $2 AND status = 'open';
+
+-- name: CreateMeetingDecision :one
+INSERT INTO meeting_decision (meeting_id, description, made_by, source_timestamp)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetMeetingDecisions :many
+SELECT * FROM meeting_decision
+WHERE meeting_id = $1
+ORDER BY source_timestamp ASC NULLS LAST, created_at ASC;
+
+-- name: DeleteMeetingDecisions :exec
+DELETE FROM meeting_decision WHERE meeting_id = $1;
+
+-- name: CreateMeetingQuestion :one
+INSERT INTO meeting_question (meeting_id, question, asked_by, source_timestamp)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: GetMeetingQuestions :many
+SELECT * FROM meeting_question
+WHERE meeting_id = $1
+ORDER BY source_timestamp ASC NULLS LAST, created_at ASC;
+
+-- name: DeleteMeetingQuestions :exec
+DELETE FROM meeting_question WHERE meeting_id = $1;
@@ -0,0 +1,17 @@
+-- name: FinishMeetingProcessing :exec
+UPDATE meeting_processing
+SET status = $2, error = $3, updated_at = NOW()
+WHERE meeting_id = $1;
+
+-- name: QueueMeetingProcessing :execrows
+INSERT INTO meeting_processing (meeting_id, status)
+VALUES ($1, 'queued')
+ON CONFLICT (meeting_id) DO UPDATE
+SET status = 'queued', step = NULL, error = NULL, attempts = 0, updated_at = NOW()
+WHERE meeting_processing.status NOT IN ('queued', 'running')
+    OR meeting_processing.updated_at < NOW() - INTERVAL '30 minutes';
+
+-- name: StartMeetingProcessingStep :exec
+UPDATE meeting_processing
+SET status = 'running', step = $2, attempts = $3, error = NULL, updated_at = NOW()
+WHERE meeting_id = $1;
@@ -0,0 +1,14 @@
+-- name: GetMeetingSentimentReport :one
+SELECT * FROM meeting_sentiment_report
+WHERE meeting_id = $1;
+
+-- name: SaveMeetingSentimentReport :exec
+INSERT INTO meeting_sentiment_report (meeting_id, overall, score, summary, participants, model)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (meeting_id) DO UPDATE
+SET overall = EXCLUDED.overall,
+    score = EXCLUDED.score,
+    summary = EXCLUDED.summary,
+    participants = EXCLUDED.participants,
+    model = EXCLUDED.model,
+    updated_at = NOW();
@@ -0,0 +1,30 @@
+-- name: ActivateMeetingSummary :execrows
+UPDATE meeting_summary
+SET active = (id = @id)
+WHERE meeting_id = @meeting_id;
+
+-- name: CreateMeetingSummary :one
+INSERT INTO meeting_summary (meeting_id, version, content, template, model, created_by)
+VALUES (
+    @meeting_id,
+    (SELECT COALESCE(MAX(version), 0) + 1 FROM meeting_summary WHERE meeting_id = @meeting_id),
+    @content,
+    @template,
+    @model,
+    @created_by
+)
+RETURNING *;
+
+-- name: GetMeetingSummaries :many
+SELECT * FROM meeting_summary
+WHERE meeting_id = $1
+ORDER BY version DESC;
+
+-- name: GetMeetingSummary :one
+SELECT * FROM meeting_summary
+WHERE id = $1 AND meeting_id = $2;
+
+-- name: SetMeetingSummary :exec
+UPDATE meeting
+SET summary = $2, updated_at = NOW()
+WHERE id = $1;
@@ -1,16 +1,34 @@
 -- name: CreateMeeting :one
-INSERT INTO meeting (name, user_id, agent_id)
-VALUES ($1, $2, $3)
+INSERT INTO meeting (name, user_id, agent_id, org_id)
+VALUES ($1, $2, $3, $4)
 RETURNING *;
 
 -- name: GetMeetingByID :one
-SELECT * FROM meeting WHERE id = $1;
+SELECT * FROM meeting
+WHERE id = $1
+    AND (
+        org_id IN (SELECT org_id FROM organization_member WHERE user_id = $2)
+        OR id IN (SELECT meeting_id FROM meeting_participant WHERE user_id = $2)
+    );
+
+-- name: GetMeetingsByStatus :many
+SELECT * FROM meeting WHERE status = $1;
+
+-- name: SearchMeetingSummaries :many
+SELECT id, name, start_time, summary FROM meeting
+WHERE org_id = @org_id
+    AND status = 'completed'
+    AND summary IS NOT NULL
+    AND name ILIKE '%' || @query::text || '%'
+ORDER BY start_time DESC NULLS LAST
+LIMIT @result_limit;
 
 -- name: GetMeetings :many
 SELECT
     m.id,
     m.name,
     m.user_id,
+    m.org_id,
     m.agent_id,
     m.start_time,
     m.end_time,
@@ -19,19 +37,29 @@ SELECT
     m.updated_at,
     COUNT(*) OVER() as total_count,
     a.name AS agent_name,
-    a.instructions AS agent_instructions
+    a.instructions AS agent_instructions,
+    mp.status AS processing_status,
+    mp.step AS processing_step,
+    mp.error AS processing_error,
+    mp.attempts AS processing_attempts
 FROM meeting AS m
 JOIN agent AS a
     ON m.agent_id = a.id
-WHERE m.user_id = $1
+LEFT JOIN meeting_processing AS mp
+    ON mp.meeting_id = m.id
+WHERE m.org_id = $1
+    AND EXISTS (
+        SELECT 1 FROM organization_member om
+        WHERE om.org_id = m.org_id AND om.user_id = $2
+    )
     AND (
         CASE
-            WHEN $2::text != '' THEN m.name ILIKE '%' || $2 || '%'
+            WHEN $3::text != '' THEN m.name ILIKE '%' || $3 || '%'
             ELSE TRUE
         END
     )
 ORDER BY m.updated_at DESC
-LIMIT $3 OFFSET $4;
+LIMIT $4 OFFSET $5;
 
 
 -- name: GetMeeting :one
@@ -39,6 +67,41 @@ SELECT
     m.id,
     m.name,
     m.user_id,
+    m.org_id,
+    m.agent_id,
+    m.start_time,
+    m.end_time,
+    m.status,
+    m.created_at,
+    m.updated_at,
+    m.transcript_url,
+    m.recording_url,
+    m.summary,
+    m.agent_version_id,
+    a.name AS agent_name,
+    a.instructions AS agent_instructions,
+    a.tools AS agent_tools,
+    a.settings AS agent_settings,
+    om.role AS member_role,
+    mp.status AS processing_status,
+    mp.step AS processing_step,
+    mp.error AS processing_error,
+    mp.attempts AS processing_attempts
+FROM meeting AS m
+JOIN agent AS a
+    ON m.agent_id = a.id
+JOIN organization_member AS om
+    ON om.org_id = m.org_id AND om.user_id = $2
+LEFT JOIN meeting_processing AS mp
+    ON mp.meeting_id = m.id
+WHERE m.id = $1;
+
+-- name: GetMeetingDetails :one
+SELECT
+    m.id,
+    m.name,
+    m.user_id,
+    m.org_id,
     m.agent_id,
     m.start_time,
     m.end_time,
@@ -48,13 +111,18 @@ SELECT
     m.transcript_url,
     m.recording_url,
     m.summary,
+    m.agent_version_id,
     a.name AS agent_name,
-    a.instructions AS agent_instructions
+    a.instructions AS agent_instructions,
+    a.tools AS agent_tools,
+    a.settings AS agent_settings
 FROM meeting AS m
 JOIN agent AS a
     ON m.agent_id = a.id
-WHERE m.id = $1
-    AND m.user_id = $2;
+WHERE m.id = $1;
+
+-- name: SetMeetingAgentVersion :exec
+UPDATE meeting SET agent_version_id = $2 WHERE id = $1;
 
 -- name: UpdateMeeting :one
 UPDATE meeting
@@ -68,11 +136,25 @@ SET
     recording_url = COALESCE($9, recording_url),
     summary = COALESCE($10, summary),
     updated_at = NOW()
-WHERE id = $1 AND user_id = $2
+WHERE id = $1
+    AND org_id IN (SELECT org_id FROM organization_member WHERE user_id = $2)
+RETURNING *;
+
+-- name: CompleteMeeting :one
+UPDATE meeting
+SET
+    status = 'completed',
+    end_time = $2,
+    transcript_url = COALESCE($3, transcript_url),
+    recording_url = COALESCE($4, recording_url),
+    updated_at = NOW()
+WHERE id = $1
 RETURNING *;
 
 -- name: DeleteMeeting :exec
-DELETE FROM meeting WHERE id = $1;
+DELETE FROM meeting
+WHERE id = $1
+    AND org_id IN (SELECT org_id FROM organization_member WHERE user_id = $2);
 
 -- name: DeleteMeetingsByUserID :exec
-DELETE FROM meeting WHERE user_id = $1;
+DELETE FROM meeting WHERE user_id = $1 AND org_id = $2;
@@ -0,0 +1,67 @@
+-- name: CreateOrganization :one
+INSERT INTO organization (name, created_by)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: GetOrganization :one
+SELECT * FROM organization WHERE id = $1;
+
+-- name: GetUserOrganizations :many
+SELECT
+    o.id,
+    o.name,
+    o.created_by,
+    o.created_at,
+    o.updated_at,
+    om.role
+FROM organization AS o
+JOIN organization_member AS om
+    ON om.org_id = o.id
+WHERE om.user_id = $1
+ORDER BY om.created_at ASC;
+
+-- name: UpdateOrganization :one
+UPDATE organization
+SET name = $2, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeleteOrganization :exec
+DELETE FROM organization WHERE id = $1;
+
+-- name: CreateOrganizationMember :one
+INSERT INTO organization_member (org_id, user_id, role)
+VALUES ($1, $2, $3)
+RETURNING *;
+
+-- name: GetOrganizationMember :one
+SELECT * FROM organization_member
+WHERE org_id = $1 AND user_id = $2;
+
+-- name: GetOrganizationMembers :many
+SELECT
+    om.org_id,
+    om.user_id,
+    om.role,
+    om.created_at,
+    om.updated_at,
+    COALESCE(u.name, '') AS user_name,
+    COALESCE(u.email, '') AS user_email
+FROM organization_member AS om
+LEFT JOIN "user" AS u
+    ON om.user_id = u.id
+WHERE om.org_id = $1
+ORDER BY om.created_at ASC;
+
+-- name: CountOrganizationOwners :one
+SELECT COUNT(*) FROM organization_member
+WHERE org_id = $1 AND role = 'owner';
+
+-- name: UpdateOrganizationMemberRole :one
+UPDATE organization_member
+SET role = $3, updated_at = NOW()
+WHERE org_id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: DeleteOrganizationMember :exec
+DELETE FROM organization_member WHERE org_id = $1 AND user_id = $2;
@@ -0,0 +1,35 @@
+-- name: CreateMeetingParticipant :one
+INSERT INTO meeting_participant (meeting_id, user_id, invited_by)
+VALUES ($1, $2, $3)
+ON CONFLICT (meeting_id, user_id) DO UPDATE SET updated_at = NOW()
+RETURNING *;
+
+-- name: GetMeetingParticipant :one
+SELECT * FROM meeting_participant
+WHERE meeting_id = $1 AND user_id = $2;
+
+-- name: GetMeetingParticipants :many
+SELECT
+    mp.id,
+    mp.meeting_id,
+    mp.user_id,
+    mp.invited_by,
+    mp.status,
+    mp.created_at,
+    mp.updated_at,
+    u.name AS user_name,
+    u.email AS user_email
+FROM meeting_participant AS mp
+JOIN "user" AS u
+    ON mp.user_id = u.id
+WHERE mp.meeting_id = $1
+ORDER BY mp.created_at ASC;
+
+-- name: UpdateMeetingParticipantStatus :one
+UPDATE meeting_participant
+SET status = $3, updated_at = NOW()
+WHERE meeting_id = $1 AND user_id = $2
+RETURNING *;
+
+-- name: DeleteMeetingParticipant :exec
+DELETE FROM meeting_participant WHERE meeting_id = $1 AND user_id = $2;
@@ -1,2 +1,5 @@
 -- name: GetUserByID :one
 SELECT * FROM "user" WHERE id = $1;
+
+-- name: GetUserByEmail :one
+SELECT * FROM "user" WHERE email = $1;
@@ -0,0 +1,51 @@
+-- name: CreateWebhookDelivery :one
+INSERT INTO webhook_delivery (endpoint_id, event, payload, replay_of)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: CreateWebhookEndpoint :one
+INSERT INTO webhook_endpoint (org_id, url, secret, events, created_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: DeleteWebhookEndpoint :exec
+DELETE FROM webhook_endpoint WHERE id = $1;
+
+-- name: GetWebhookDeliveries :many
+SELECT * FROM webhook_delivery
+WHERE endpoint_id = $1
+ORDER BY created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- name: GetWebhookDelivery :one
+SELECT * FROM webhook_delivery WHERE id = $1;
+
+-- name: GetWebhookEndpoint :one
+SELECT * FROM webhook_endpoint WHERE id = $1;
+
+-- name: GetWebhookEndpoints :many
+SELECT * FROM webhook_endpoint
+WHERE org_id = $1
+ORDER BY created_at DESC;
+
+-- name: GetWebhookEndpointsForEvent :many
+SELECT * FROM webhook_endpoint
+WHERE org_id = @org_id AND active
+  AND (cardinality(events) = 0 OR @event::text = ANY(events));
+
+-- name: RecordWebhookDeliveryAttempt :one
+UPDATE webhook_delivery
+SET status = $2,
+    attempts = attempts + 1,
+    response_status = $3,
+    last_error = $4,
+    delivered_at = CASE WHEN $2 = 'succeeded' THEN NOW() ELSE delivered_at END,
+    updated_at = NOW()
+WHERE id = $1
+RETURNING *;
+
+-- name: UpdateWebhookEndpoint :one
+UPDATE webhook_endpoint
+SET url = $2, events = $3, active = $4, updated_at = NOW()
+WHERE id = $1
+RETURNING *;
@@ -4,12 +4,19 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/rahulSailesh-shah/converSense/pkg/agentconfig"
+	"github.com/rahulSailesh-shah/converSense/pkg/textdiff"
 )
 
 type CreateAgentRequest struct {
-	Name         string `json:"name" binding:"required"`
-	UserID       string `json:"-"`
-	Instructions string `json:"instructions" binding:"required"`
+	Name         string    `json:"name" binding:"required"`
+	UserID       string    `json:"-"`
+	OrgID        uuid.UUID `json:"-"`
+	Instructions string    `json:"instructions" binding:"required"`
+	// Tools defaults to agentconfig.DefaultTools when omitted
+	Tools []agentconfig.Tool `json:"tools"`
+	// Settings left empty fall back to the server defaults
+	Settings agentconfig.Settings `json:"settings"`
 }
 
 type UpdateAgentRequest struct {
@@ -17,13 +24,18 @@ type UpdateAgentRequest struct {
 	UserID       string    `json:"-"`
 	Name         string    `json:"name,omitempty"`
 	Instructions string    `json:"instructions,omitempty"`
+	// Tools replaces the agent's tools when present; an empty list disables all tools
+	Tools []agentconfig.Tool `json:"tools,omitempty"`
+	// Settings replaces the agent's settings when present
+	Settings *agentconfig.Settings `json:"settings,omitempty"`
 }
 
 type GetAgentsRequest struct {
-	UserID string `form:"userId"`
-	Search string `form:"search"`
-	Limit  int32  `form:"limit"`
-	Offset int32  `form:"offset"`
+	UserID string    `form:"userId"`
+	OrgID  uuid.UUID `form:"-"`
+	Search string    `form:"search"`
+	Limit  int32     `form:"limit"`
+	Offset int32     `form:"offset"`
 }
 
 type GetAgentRequest struct {
@@ -42,13 +54,89 @@ type AgentDetails struct {
 }
 
 type AgentResponse struct {
-	ID           uuid.UUID `db:"id" json:"id"`
-	Name         string    `db:"name" json:"name"`
-	UserID       string    `db:"user_id" json:"userId"`
-	Instructions string    `db:"instructions" json:"instructions"`
-	MeetingCount int64     `db:"meeting_count" json:"meetingCount"`
-	CreatedAt    time.Time `db:"created_at" json:"createdAt"`
-	UpdatedAt    time.Time `db:"updated_at" json:"updatedAt"`
+	ID           uuid.UUID            `db:"id" json:"id"`
+	Name         string               `db:"name" json:"name"`
+	UserID       string               `db:"user_id" json:"userId"`
+	OrgID        uuid.UUID            `db:"org_id" json:"orgId"`
+	Instructions string               `db:"instructions" json:"instructions"`
+	Tools        []agentconfig.Tool   `db:"tools" json:"tools"`
+	Settings     agentconfig.Settings `db:"settings" json:"settings"`
+	MeetingCount int64                `db:"meeting_count" json:"meetingCount"`
+	CreatedAt    time.Time            `db:"created_at" json:"createdAt"`
+	UpdatedAt    time.Time            `db:"updated_at" json:"updatedAt"`
+}
+
+type CloneAgentRequest struct {
+	ID     uuid.UUID `json:"-"`
+	UserID string    `json:"-"`
+	// Name defaults to the source agent's name with a "(copy)" suffix
+	Name string `json:"name,omitempty"`
+}
+
+type GetAgentVersionsRequest struct {
+	AgentID uuid.UUID `json:"-"`
+	UserID  string    `json:"-"`
+}
+
+type DiffAgentVersionsRequest struct {
+	AgentID uuid.UUID `json:"-"`
+	UserID  string    `json:"-"`
+	From    int32     `form:"from" binding:"required"`
+	To      int32     `form:"to" binding:"required"`
+}
+
+type RollbackAgentRequest struct {
+	AgentID uuid.UUID `json:"-"`
+	UserID  string    `json:"-"`
+	Version int32     `json:"-"`
+}
+
+type AgentVersionResponse struct {
+	ID             uuid.UUID            `json:"id"`
+	AgentID        uuid.UUID            `json:"agentId"`
+	Version        int32                `json:"version"`
+	Name           string               `json:"name"`
+	Instructions   string               `json:"instructions"`
+	Tools          []agentconfig.Tool   `json:"tools"`
+	Settings       agentconfig.Settings `json:"settings"`
+	RolledBackFrom *int32               `json:"rolledBackFrom,omitempty"`
+	CreatedAt      time.Time            `json:"createdAt"`
+}
+
+type AgentVersionDiffResponse struct {
+	From AgentVersionResponse `json:"from"`
+	To   AgentVersionResponse `json:"to"`
+	// Changed lists the fields that differ: name, instructions, tools, settings
+	Changed      []string        `json:"changed"`
+	Instructions []textdiff.Line `json:"instructions"`
+}
+
+type UploadAgentDocumentRequest struct {
+	AgentID  uuid.UUID `json:"-"`
+	UserID   string    `json:"-"`
+	FileName string    `json:"-"`
+	Data     []byte    `json:"-"`
+}
+
+type GetAgentDocumentsRequest struct {
+	AgentID uuid.UUID `json:"-"`
+	UserID  string    `json:"-"`
+}
+
+type DeleteAgentDocumentRequest struct {
+	ID      uuid.UUID `json:"-"`
+	AgentID uuid.UUID `json:"-"`
+	UserID  string    `json:"-"`
+}
+
+type AgentDocumentResponse struct {
+	ID          uuid.UUID `json:"id"`
+	AgentID     uuid.UUID `json:"agentId"`
+	Name        string    `json:"name"`
+	ContentType string    `json:"contentType"`
+	SizeBytes   int64     `json:"sizeBytes"`
+	ChunkCount  int32     `json:"chunkCount"`
+	CreatedAt   time.Time `json:"createdAt"`
 }
 
 type PaginatedAgentsResponse struct {
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateAPIKeyRequest struct {
+	UserID string   `json:"-"`
+	Name   string   `json:"name" binding:"required"`
+	Scopes []string `json:"scopes" binding:"required"`
+	// ExpiresAt is optional; keys without it never expire
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+type RevokeAPIKeyRequest struct {
+	ID     uuid.UUID `json:"-"`
+	UserID string    `json:"-"`
+}
+
+type APIKeyResponse struct {
+	ID         uuid.UUID  `json:"id"`
+	Name       string     `json:"name"`
+	Prefix     string     `json:"prefix"`
+	Scopes     []string   `json:"scopes"`
+	ExpiresAt  *time.Time `json:"expiresAt"`
+	LastUsedAt *time.Time `json:"lastUsedAt"`
+	RevokedAt  *time.Time `json:"revokedAt"`
+	CreatedAt  time.Time  `json:"createdAt"`
+}
+
+type CreatedAPIKeyResponse struct {
+	APIKeyResponse
+	// Key is only returned when the key is created
+	Key string `json:"key"`
+}
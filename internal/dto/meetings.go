@@ -10,6 +10,7 @@ import (
 type CreateMeetingRequest struct {
 	Name    string    `json:"name" binding:"required"`
 	UserID  string    `json:"-"`
+	OrgID   uuid.UUID `json:"-"`
 	AgentID uuid.UUID `json:"agentId" binding:"required"`
 }
 
@@ -32,10 +33,11 @@ type UpdateMeetingRequest struct {
 }
 
 type GetMeetingsRequest struct {
-	UserID string `form:"userId"`
-	Search string `form:"search"`
-	Limit  int32  `form:"limit"`
-	Offset int32  `form:"offset"`
+	UserID string    `form:"userId"`
+	OrgID  uuid.UUID `form:"-"`
+	Search string    `form:"search"`
+	Limit  int32     `form:"limit"`
+	Offset int32     `form:"offset"`
 }
 
 type GetMeetingRequest struct {
@@ -54,22 +56,101 @@ type GetPreSignedRecordingURLRequest struct {
 	FileType  string    `json:"fileType" binding:"required,oneof=recording transcript"` // "recording" or "transcript"
 }
 
+type InviteParticipantRequest struct {
+	MeetingID uuid.UUID `json:"-"`
+	UserID    string    `json:"-"`
+	Email     string    `json:"email,omitempty" binding:"required_without=InviteeID"`
+	InviteeID string    `json:"userId,omitempty" binding:"required_without=Email"`
+}
+
+type GetParticipantsRequest struct {
+	MeetingID uuid.UUID `json:"-"`
+	UserID    string    `json:"-"`
+}
+
+type RemoveParticipantRequest struct {
+	MeetingID uuid.UUID `json:"-"`
+	UserID    string    `json:"-"`
+	InviteeID string    `json:"-"`
+}
+
+type GetMeetingNotesRequest struct {
+	MeetingID uuid.UUID `json:"-"`
+	UserID    string    `json:"-"`
+}
+
+// GetMeetingItemsRequest reads the action items, decisions, open questions,
+// summary history or sentiment report of a meeting.
+type GetMeetingItemsRequest struct {
+	MeetingID uuid.UUID `json:"-"`
+	UserID    string    `json:"-"`
+}
+
+type UpdateActionItemRequest struct {
+	MeetingID uuid.UUID `json:"-"`
+	ItemID    uuid.UUID `json:"-"`
+	UserID    string    `json:"-"`
+	Status    string    `json:"status" binding:"required,oneof=open done"`
+}
+
+// ReprocessMeetingRequest re-runs post-processing for a completed meeting.
+// Leaving Steps empty runs every step; Template and Model override the agent's
+// summary template and the configured summary model. Model must be one of the
+// configured summary model's allowed alternatives.
+type ReprocessMeetingRequest struct {
+	MeetingID uuid.UUID `json:"-"`
+	UserID    string    `json:"-"`
+	Steps     []string  `json:"steps" binding:"omitempty,dive,oneof=summary action_items sentiment"`
+	Template  string    `json:"template,omitempty"`
+	Model     string    `json:"model,omitempty" binding:"omitempty,max=255"`
+}
+
+type ActivateSummaryRequest struct {
+	MeetingID uuid.UUID `json:"-"`
+	SummaryID uuid.UUID `json:"-"`
+	UserID    string    `json:"-"`
+}
+
+type StopMeetingRequest struct {
+	ID     uuid.UUID `json:"-"`
+	UserID string    `json:"-"`
+}
+
+type JoinMeetingRequest struct {
+	ID     uuid.UUID `json:"-"`
+	UserID string    `json:"-"`
+}
+
 // Responses
 
 type MeetingResponse struct {
-	ID            uuid.UUID     `db:"id" json:"id"`
-	Name          string        `db:"name" json:"name"`
-	UserID        string        `db:"user_id" json:"userId"`
-	AgentID       uuid.UUID     `db:"agent_id" json:"agentId"`
-	StartTime     *time.Time    `db:"start_time" json:"startTime"`
-	EndTime       *time.Time    `db:"end_time" json:"endTime"`
-	Status        string        `db:"status" json:"status"`
-	TranscriptUrl *string       `db:"transcript_url" json:"transcriptUrl"`
-	RecordingUrl  *string       `db:"recording_url" json:"recordingUrl"`
-	Summary       *string       `db:"summary" json:"summary"`
-	CreatedAt     time.Time     `db:"created_at" json:"createdAt"`
-	UpdatedAt     time.Time     `db:"updated_at" json:"updatedAt"`
-	AgentDetails  *AgentDetails `json:"agentDetails,omitempty"`
+	ID            uuid.UUID  `db:"id" json:"id"`
+	Name          string     `db:"name" json:"name"`
+	UserID        string     `db:"user_id" json:"userId"`
+	OrgID         uuid.UUID  `db:"org_id" json:"orgId"`
+	AgentID       uuid.UUID  `db:"agent_id" json:"agentId"`
+	StartTime     *time.Time `db:"start_time" json:"startTime"`
+	EndTime       *time.Time `db:"end_time" json:"endTime"`
+	Status        string     `db:"status" json:"status"`
+	TranscriptUrl *string    `db:"transcript_url" json:"transcriptUrl"`
+	RecordingUrl  *string    `db:"recording_url" json:"recordingUrl"`
+	Summary       *string    `db:"summary" json:"summary"`
+	CreatedAt     time.Time  `db:"created_at" json:"createdAt"`
+	UpdatedAt     time.Time  `db:"updated_at" json:"updatedAt"`
+	// AgentVersionID is the agent version the meeting ran with
+	AgentVersionID *uuid.UUID    `db:"agent_version_id" json:"agentVersionId,omitempty"`
+	AgentDetails   *AgentDetails `json:"agentDetails,omitempty"`
+	// Processing is the state of post-processing, absent until it is queued
+	Processing *ProcessingStatus `json:"processing,omitempty"`
+}
+
+// ProcessingStatus tracks summarization and the other post-processing steps
+// of a completed meeting.
+type ProcessingStatus struct {
+	Status   string  `json:"status"` // "queued", "running", "succeeded" or "failed"
+	Step     *string `json:"step"`
+	Error    *string `json:"error"`
+	Attempts int32   `json:"attempts"`
 }
 
 type PaginatedMeetingsResponse struct {
@@ -80,3 +161,104 @@ type PaginatedMeetingsResponse struct {
 	CurrentPage     int32             `json:"currentPage"`
 	TotalPages      int32             `json:"totalPages"`
 }
+
+type ParticipantResponse struct {
+	ID        uuid.UUID `json:"id"`
+	MeetingID uuid.UUID `json:"meetingId"`
+	UserID    string    `json:"userId"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	InvitedBy string    `json:"invitedBy"`
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type MeetingNoteResponse struct {
+	ID        uuid.UUID  `json:"id"`
+	MeetingID uuid.UUID  `json:"meetingId"`
+	Kind      string     `json:"kind"`
+	Content   string     `json:"content"`
+	Author    *string    `json:"author"`
+	RemindAt  *time.Time `json:"remindAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+}
+
+type MeetingActionItemResponse struct {
+	ID              uuid.UUID  `json:"id"`
+	MeetingID       uuid.UUID  `json:"meetingId"`
+	Description     string     `json:"description"`
+	Owner           *string    `json:"owner"`
+	DueDate         *time.Time `json:"dueDate"`
+	SourceTimestamp *time.Time `json:"sourceTimestamp"`
+	// Source is "agent" for items captured live and "summary" for items
+	// extracted after the meeting
+	Source    string    `json:"source"`
+	Status    string    `json:"status"`
+	CreatedBy *string   `json:"createdBy"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type MeetingDecisionResponse struct {
+	ID              uuid.UUID  `json:"id"`
+	MeetingID       uuid.UUID  `json:"meetingId"`
+	Description     string     `json:"description"`
+	MadeBy          *string    `json:"madeBy"`
+	SourceTimestamp *time.Time `json:"sourceTimestamp"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
+
+type MeetingQuestionResponse struct {
+	ID              uuid.UUID  `json:"id"`
+	MeetingID       uuid.UUID  `json:"meetingId"`
+	Question        string     `json:"question"`
+	AskedBy         *string    `json:"askedBy"`
+	SourceTimestamp *time.Time `json:"sourceTimestamp"`
+	CreatedAt       time.Time  `json:"createdAt"`
+}
+
+type MeetingSummaryResponse struct {
+	ID        uuid.UUID `json:"id"`
+	MeetingID uuid.UUID `json:"meetingId"`
+	Version   int32     `json:"version"`
+	Content   string    `json:"content"`
+	Template  *string   `json:"template"`
+	Model     *string   `json:"model"`
+	// Active marks the summary shown on the meeting
+	Active    bool      `json:"active"`
+	CreatedBy *string   `json:"createdBy"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// MeetingSentimentReportResponse is the post-meeting sentiment of the
+// conversation and of each participant, scored from -1 to 1.
+type MeetingSentimentReportResponse struct {
+	MeetingID    uuid.UUID              `json:"meetingId"`
+	Overall      string                 `json:"overall"` // "positive", "negative", "neutral" or "mixed"
+	Score        float64                `json:"score"`
+	Summary      string                 `json:"summary"`
+	Participants []ParticipantSentiment `json:"participants"`
+	Model        *string                `json:"model"`
+	CreatedAt    time.Time              `json:"createdAt"`
+	UpdatedAt    time.Time              `json:"updatedAt"`
+}
+
+type ParticipantSentiment struct {
+	Name      string  `json:"name"`
+	Sentiment string  `json:"sentiment"`
+	Score     float64 `json:"score"`
+	Notes     string  `json:"notes"`
+}
+
+type LiveSessionResponse struct {
+	MeetingID       uuid.UUID  `json:"meetingId"`
+	Live            bool       `json:"live"`
+	Status          string     `json:"status"`
+	RealtimeModel   string     `json:"realtimeModel,omitempty"`
+	ConnectionState string     `json:"connectionState,omitempty"`
+	Participants    int        `json:"participants"`
+	Recording       bool       `json:"recording"`
+	StartedAt       *time.Time `json:"startedAt,omitempty"`
+	UptimeSeconds   int64      `json:"uptimeSeconds"`
+}
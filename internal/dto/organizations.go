@@ -0,0 +1,69 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateOrganizationRequest struct {
+	Name   string `json:"name" binding:"required"`
+	UserID string `json:"-"`
+}
+
+type UpdateOrganizationRequest struct {
+	ID     uuid.UUID `json:"-"`
+	UserID string    `json:"-"`
+	Name   string    `json:"name" binding:"required"`
+}
+
+type DeleteOrganizationRequest struct {
+	ID     uuid.UUID `json:"-"`
+	UserID string    `json:"-"`
+}
+
+type GetOrganizationMembersRequest struct {
+	OrgID  uuid.UUID `json:"-"`
+	UserID string    `json:"-"`
+}
+
+type AddOrganizationMemberRequest struct {
+	OrgID    uuid.UUID `json:"-"`
+	UserID   string    `json:"-"`
+	Email    string    `json:"email,omitempty" binding:"required_without=MemberID"`
+	MemberID string    `json:"userId,omitempty" binding:"required_without=Email"`
+	Role     string    `json:"role" binding:"required,oneof=owner admin member viewer"`
+}
+
+type UpdateOrganizationMemberRequest struct {
+	OrgID    uuid.UUID `json:"-"`
+	UserID   string    `json:"-"`
+	MemberID string    `json:"-"`
+	Role     string    `json:"role" binding:"required,oneof=owner admin member viewer"`
+}
+
+type RemoveOrganizationMemberRequest struct {
+	OrgID    uuid.UUID `json:"-"`
+	UserID   string    `json:"-"`
+	MemberID string    `json:"-"`
+}
+
+type OrganizationResponse struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	CreatedBy string    `json:"createdBy"`
+	// Role is the requesting user's role in the organization
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type OrganizationMemberResponse struct {
+	OrgID     uuid.UUID `json:"orgId"`
+	UserID    string    `json:"userId"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Role      string    `json:"role"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type CreateWebhookEndpointRequest struct {
+	UserID string    `json:"-"`
+	OrgID  uuid.UUID `json:"-"`
+	URL    string    `json:"url" binding:"required,url"`
+	// Secret is generated when left empty
+	Secret string `json:"secret"`
+	// Events to send; empty subscribes to every event
+	Events []string `json:"events"`
+}
+
+type UpdateWebhookEndpointRequest struct {
+	ID     uuid.UUID `json:"-"`
+	UserID string    `json:"-"`
+	URL    string    `json:"url" binding:"required,url"`
+	Events []string  `json:"events"`
+	Active *bool     `json:"active"`
+}
+
+type DeleteWebhookEndpointRequest struct {
+	ID     uuid.UUID `json:"-"`
+	UserID string    `json:"-"`
+}
+
+type GetWebhookDeliveriesRequest struct {
+	EndpointID uuid.UUID
+	UserID     string
+	Limit      int32
+	Offset     int32
+}
+
+type ReplayWebhookDeliveryRequest struct {
+	EndpointID uuid.UUID
+	DeliveryID uuid.UUID
+	UserID     string
+}
+
+type WebhookEndpointResponse struct {
+	ID        uuid.UUID `json:"id"`
+	OrgID     uuid.UUID `json:"orgId"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	Active    bool      `json:"active"`
+	CreatedBy string    `json:"createdBy"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type CreatedWebhookEndpointResponse struct {
+	WebhookEndpointResponse
+	// Secret is only returned when the endpoint is created
+	Secret string `json:"secret"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             uuid.UUID       `json:"id"`
+	EndpointID     uuid.UUID       `json:"endpointId"`
+	Event          string          `json:"event"`
+	Payload        json.RawMessage `json:"payload"`
+	Status         string          `json:"status"`
+	Attempts       int32           `json:"attempts"`
+	ResponseStatus *int32          `json:"responseStatus"`
+	LastError      *string         `json:"lastError"`
+	ReplayOf       *uuid.UUID      `json:"replayOf"`
+	DeliveredAt    *time.Time      `json:"deliveredAt"`
+	CreatedAt      time.Time       `json:"createdAt"`
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/rahulSailesh-shah/converSense/internal/app"
 	"github.com/rahulSailesh-shah/converSense/internal/transport/http"
 	"github.com/rahulSailesh-shah/converSense/pkg/auth"
+	"github.com/rahulSailesh-shah/converSense/pkg/config"
 )
 
 type Server struct {
@@ -26,13 +27,14 @@ type Server struct {
 func NewServer(ctx context.Context, app *app.App) (*Server, error) {
 	engine := gin.Default()
 
-	authKeys, err := auth.LoadKeys(app.Config.Auth.JwksURL)
+	verifier, err := auth.NewVerifier(ctx, authIssuers(app.Config.Auth),
+		time.Duration(app.Config.Auth.JwksRefreshMin)*time.Minute)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load auth keys: %w", err)
 	}
 
 	// register routes here
-	http.RegisterRoutes(engine, authKeys, app )
+	http.RegisterRoutes(engine, verifier, app )
 
 	srv := &httpSrv.Server{
 		Addr:    fmt.Sprintf(":%d", app.Config.Server.Port),
@@ -47,6 +49,26 @@ func NewServer(ctx context.Context, app *app.App) (*Server, error) {
 	}, nil
 }
 
+// authIssuers lists BetterAuth first, followed by any other trusted issuers.
+// Only BetterAuth subjects are local user IDs; the others are namespaced by
+// their issuer URL.
+func authIssuers(cfg config.AuthConfig) []auth.Issuer {
+	issuers := []auth.Issuer{{
+		URL:      cfg.Issuer,
+		JwksURL:  cfg.JwksURL,
+		Audience: cfg.Audience,
+	}}
+	for _, trusted := range cfg.TrustedIssuers {
+		issuers = append(issuers, auth.Issuer{
+			URL:       trusted.Issuer,
+			JwksURL:   trusted.JwksURL,
+			Audience:  trusted.Audience,
+			Namespace: trusted.Issuer,
+		})
+	}
+	return issuers
+}
+
 
 func(s *Server) Run() error {
 	done := make(chan bool, 1)
@@ -79,6 +101,14 @@ func (s *Server) gracefulShutdown(done chan bool) {
 		log.Printf("Server forced to shutdown with error: %v", err)
 	}
 
+	// Stop live meetings before the DB closes so they can be finalised
+	drainTimeout := time.Duration(s.App.Config.Server.SessionDrainSec) * time.Second
+	drainCtx, drainCancel := context.WithTimeout(s.ctx, drainTimeout)
+	defer drainCancel()
+	if err := s.App.Service.Meeting.Shutdown(drainCtx); err != nil {
+		log.Printf("Failed to drain meeting sessions: %v", err)
+	}
+
 	log.Println("Server exiting")
 	done <- true
 }
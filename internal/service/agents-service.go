@@ -1,12 +1,18 @@
 package service
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"reflect"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
 	"github.com/rahulSailesh-shah/converSense/internal/dto"
+	"github.com/rahulSailesh-shah/converSense/pkg/agentconfig"
+	"github.com/rahulSailesh-shah/converSense/pkg/authz"
+	"github.com/rahulSailesh-shah/converSense/pkg/knowledge"
+	"github.com/rahulSailesh-shah/converSense/pkg/textdiff"
 )
 
 type AgentService interface {
@@ -15,64 +21,153 @@ type AgentService interface {
 	GetAgents(ctx context.Context, request dto.GetAgentsRequest) (*dto.PaginatedAgentsResponse, error)
 	GetAgent(ctx context.Context, request dto.GetAgentRequest) (*dto.AgentResponse, error)
 	DeleteAgent(ctx context.Context, request dto.DeleteAgentRequest) error
+	CloneAgent(ctx context.Context, request dto.CloneAgentRequest) (*dto.AgentResponse, error)
+	GetAgentTemplates(ctx context.Context) []agentconfig.Template
+	GetSummaryTemplates(ctx context.Context) []agentconfig.SummaryTemplate
+	GetAgentVersions(ctx context.Context, request dto.GetAgentVersionsRequest) ([]dto.AgentVersionResponse, error)
+	DiffAgentVersions(ctx context.Context, request dto.DiffAgentVersionsRequest) (*dto.AgentVersionDiffResponse, error)
+	RollbackAgent(ctx context.Context, request dto.RollbackAgentRequest) (*dto.AgentResponse, error)
+	UploadAgentDocument(ctx context.Context, request dto.UploadAgentDocumentRequest) (*dto.AgentDocumentResponse, error)
+	GetAgentDocuments(ctx context.Context, request dto.GetAgentDocumentsRequest) ([]dto.AgentDocumentResponse, error)
+	DeleteAgentDocument(ctx context.Context, request dto.DeleteAgentDocumentRequest) error
 }
 
 type agentService struct {
-	queries *repo.Queries
-	db      *pgxpool.Pool
+	queries   *repo.Queries
+	db        *pgxpool.Pool
+	knowledge *knowledge.Base
 }
 
-func NewAgentService(db *pgxpool.Pool, queries *repo.Queries) AgentService {
+func NewAgentService(db *pgxpool.Pool, queries *repo.Queries, knowledge *knowledge.Base) AgentService {
 	return &agentService{
-		db:      db,
-		queries: queries,
+		db:        db,
+		queries:   queries,
+		knowledge: knowledge,
 	}
 }
 
 func (s *agentService) CreateAgent(ctx context.Context, request dto.CreateAgentRequest) (*dto.AgentResponse, error) {
-	newAgent, err := s.queries.CreateAgent(ctx, repo.CreateAgentParams{
+	tools := request.Tools
+	if tools == nil {
+		tools = agentconfig.DefaultTools()
+	}
+	toolsJSON, err := agentconfig.MarshalTools(tools)
+	if err != nil {
+		return nil, err
+	}
+	settingsJSON, err := agentconfig.MarshalSettings(request.Settings)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeInOrg(ctx, s.queries, request.UserID, request.OrgID, authz.ResourceAgent, authz.ActionCreate); err != nil {
+		return nil, err
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	newAgent, err := qtx.CreateAgent(ctx, repo.CreateAgentParams{
 		Name:         request.Name,
 		UserID:       request.UserID,
+		OrgID:        request.OrgID,
 		Instructions: request.Instructions,
+		Tools:        toolsJSON,
+		Settings:     settingsJSON,
 	})
 	if err != nil {
 		return nil, err
 	}
+	if err := recordAgentVersion(ctx, qtx, newAgent, nil); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
 	return toAgentResponse(newAgent), nil
 }
 
 func (s *agentService) UpdateAgent(ctx context.Context, request dto.UpdateAgentRequest) (*dto.AgentResponse, error) {
-	currentAgent, err := s.queries.GetAgent(ctx, repo.GetAgentParams{
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	// Lock the agent so concurrent updates and rollbacks apply in turn: each
+	// merges onto the latest config and numbers its version after the last.
+	if err := qtx.LockAgent(ctx, request.ID); err != nil {
+		return nil, err
+	}
+	currentAgent, err := qtx.GetAgent(ctx, repo.GetAgentParams{
 		AgentID: request.ID,
 		UserID:  request.UserID,
 	})
 	if err != nil {
 		return nil, err
 	}
+	if err := authorizeOwned(request.UserID, currentAgent.UserID, currentAgent.OrgID, currentAgent.MemberRole, authz.ResourceAgent, authz.ActionUpdate); err != nil {
+		return nil, err
+	}
 
+	previous := currentAgent
 	if request.Name != "" {
 		currentAgent.Name = request.Name
 	}
 	if request.Instructions != "" {
 		currentAgent.Instructions = request.Instructions
 	}
+	if request.Tools != nil {
+		currentTools, err := agentconfig.ParseTools(currentAgent.Tools)
+		if err != nil {
+			return nil, err
+		}
+		agentconfig.KeepWebhookHeaders(request.Tools, currentTools)
+		currentAgent.Tools, err = agentconfig.MarshalTools(request.Tools)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if request.Settings != nil {
+		currentAgent.Settings, err = agentconfig.MarshalSettings(*request.Settings)
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	updatedAgent, err := s.queries.UpdateAgent(ctx, repo.UpdateAgentParams{
+	updatedAgent, err := qtx.UpdateAgent(ctx, repo.UpdateAgentParams{
 		ID:           currentAgent.ID,
 		Name:         currentAgent.Name,
 		Instructions: currentAgent.Instructions,
+		Tools:        currentAgent.Tools,
+		Settings:     currentAgent.Settings,
+		UserID:       request.UserID,
 	})
 	if err != nil {
 		return nil, err
 	}
+	if agentConfigChanged(previous, updatedAgent) {
+		if err := recordAgentVersion(ctx, qtx, updatedAgent, nil); err != nil {
+			return nil, err
+		}
+	}
 
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
 	return toAgentResponse(updatedAgent), nil
 }
 
 func (s *agentService) GetAgents(ctx context.Context, request dto.GetAgentsRequest) (*dto.PaginatedAgentsResponse, error) {
 	rows, err := s.queries.GetAgents(ctx, repo.GetAgentsParams{
+		OrgID:   request.OrgID,
 		UserID:  request.UserID,
-		Column2: request.Search,
+		Column3: request.Search,
 		Limit:   request.Limit,
 		Offset:  request.Offset,
 	})
@@ -89,8 +184,11 @@ func (s *agentService) GetAgents(ctx context.Context, request dto.GetAgentsReque
 		agents = append(agents, dto.AgentResponse{
 			ID:           row.ID,
 			UserID:       row.UserID,
+			OrgID:        row.OrgID,
 			Name:         row.Name,
 			Instructions: row.Instructions,
+			Tools:        parseAgentTools(row.Tools),
+			Settings:     parseAgentSettings(row.Settings),
 			CreatedAt:    row.CreatedAt,
 			UpdatedAt:    row.UpdatedAt,
 			MeetingCount: row.MeetingCount,
@@ -111,8 +209,19 @@ func (s *agentService) GetAgents(ctx context.Context, request dto.GetAgentsReque
 }
 
 func (s *agentService) DeleteAgent(ctx context.Context, request dto.DeleteAgentRequest) error {
+	agent, err := s.queries.GetAgent(ctx, repo.GetAgentParams{
+		AgentID: request.ID,
+		UserID:  request.UserID,
+	})
+	if err != nil {
+		return err
+	}
+	if err := authorizeOwned(request.UserID, agent.UserID, agent.OrgID, agent.MemberRole, authz.ResourceAgent, authz.ActionDelete); err != nil {
+		return err
+	}
+
 	fmt.Println("Deleting agent with ID:", request.ID)
-	err := s.queries.DeleteAgent(ctx, repo.DeleteAgentParams{
+	err = s.queries.DeleteAgent(ctx, repo.DeleteAgentParams{
 		ID:     request.ID,
 		UserID: request.UserID,
 	})
@@ -132,24 +241,350 @@ func (s *agentService) GetAgent(ctx context.Context, request dto.GetAgentRequest
 	if err != nil {
 		return nil, err
 	}
+	if err := authorize(request.UserID, agent.OrgID, agent.MemberRole, authz.ResourceAgent, authz.ActionRead); err != nil {
+		return nil, err
+	}
 	return &dto.AgentResponse{
 		ID:           agent.ID,
 		Name:         agent.Name,
 		UserID:       agent.UserID,
+		OrgID:        agent.OrgID,
 		Instructions: agent.Instructions,
+		Tools:        parseAgentTools(agent.Tools),
+		Settings:     parseAgentSettings(agent.Settings),
 		CreatedAt:    agent.CreatedAt,
 		UpdatedAt:    agent.UpdatedAt,
 		MeetingCount: agent.MeetingCount,
 	}, nil
 }
 
+// CloneAgent copies an agent's name, instructions, tools and settings into a
+// new agent in the same organization. Documents and history are not copied.
+func (s *agentService) CloneAgent(ctx context.Context, request dto.CloneAgentRequest) (*dto.AgentResponse, error) {
+	source, err := s.queries.GetAgent(ctx, repo.GetAgentParams{
+		AgentID: request.ID,
+		UserID:  request.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get agent: %w", err)
+	}
+	if err := authorize(request.UserID, source.OrgID, source.MemberRole, authz.ResourceAgent, authz.ActionCreate); err != nil {
+		return nil, err
+	}
+
+	name := request.Name
+	if name == "" {
+		name = source.Name + " (copy)"
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	clonedAgent, err := qtx.CreateAgent(ctx, repo.CreateAgentParams{
+		Name:         name,
+		UserID:       request.UserID,
+		OrgID:        source.OrgID,
+		Instructions: source.Instructions,
+		Tools:        source.Tools,
+		Settings:     source.Settings,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := recordAgentVersion(ctx, qtx, clonedAgent, nil); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return toAgentResponse(clonedAgent), nil
+}
+
+func (s *agentService) GetAgentTemplates(ctx context.Context) []agentconfig.Template {
+	return agentconfig.Templates()
+}
+
+func (s *agentService) GetSummaryTemplates(ctx context.Context) []agentconfig.SummaryTemplate {
+	return agentconfig.SummaryTemplates()
+}
+
+func (s *agentService) GetAgentVersions(ctx context.Context, request dto.GetAgentVersionsRequest) ([]dto.AgentVersionResponse, error) {
+	agent, err := s.queries.GetAgent(ctx, repo.GetAgentParams{
+		AgentID: request.AgentID,
+		UserID:  request.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get agent: %w", err)
+	}
+	if err := authorize(request.UserID, agent.OrgID, agent.MemberRole, authz.ResourceAgent, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.GetAgentVersions(ctx, request.AgentID)
+	if err != nil {
+		return nil, err
+	}
+	versions := make([]dto.AgentVersionResponse, 0, len(rows))
+	for _, row := range rows {
+		versions = append(versions, toAgentVersionResponse(row))
+	}
+	return versions, nil
+}
+
+func (s *agentService) DiffAgentVersions(ctx context.Context, request dto.DiffAgentVersionsRequest) (*dto.AgentVersionDiffResponse, error) {
+	agent, err := s.queries.GetAgent(ctx, repo.GetAgentParams{
+		AgentID: request.AgentID,
+		UserID:  request.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get agent: %w", err)
+	}
+	if err := authorize(request.UserID, agent.OrgID, agent.MemberRole, authz.ResourceAgent, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	from, err := s.queries.GetAgentVersion(ctx, repo.GetAgentVersionParams{
+		AgentID: request.AgentID,
+		Version: request.From,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get version %d: %w", request.From, err)
+	}
+	to, err := s.queries.GetAgentVersion(ctx, repo.GetAgentVersionParams{
+		AgentID: request.AgentID,
+		Version: request.To,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get version %d: %w", request.To, err)
+	}
+
+	diff := &dto.AgentVersionDiffResponse{
+		From:         toAgentVersionResponse(from),
+		To:           toAgentVersionResponse(to),
+		Changed:      []string{},
+		Instructions: textdiff.Lines(from.Instructions, to.Instructions),
+	}
+	if from.Name != to.Name {
+		diff.Changed = append(diff.Changed, "name")
+	}
+	if from.Instructions != to.Instructions {
+		diff.Changed = append(diff.Changed, "instructions")
+	}
+	if !reflect.DeepEqual(diff.From.Tools, diff.To.Tools) {
+		diff.Changed = append(diff.Changed, "tools")
+	}
+	if !reflect.DeepEqual(diff.From.Settings, diff.To.Settings) {
+		diff.Changed = append(diff.Changed, "settings")
+	}
+	return diff, nil
+}
+
+// RollbackAgent restores the configuration of an earlier version. The
+// rollback is itself recorded as a new version, so history is never lost.
+func (s *agentService) RollbackAgent(ctx context.Context, request dto.RollbackAgentRequest) (*dto.AgentResponse, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	// Serialized with updates like UpdateAgent.
+	if err := qtx.LockAgent(ctx, request.AgentID); err != nil {
+		return nil, err
+	}
+	agent, err := qtx.GetAgent(ctx, repo.GetAgentParams{
+		AgentID: request.AgentID,
+		UserID:  request.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get agent: %w", err)
+	}
+	if err := authorizeOwned(request.UserID, agent.UserID, agent.OrgID, agent.MemberRole, authz.ResourceAgent, authz.ActionUpdate); err != nil {
+		return nil, err
+	}
+
+	version, err := qtx.GetAgentVersion(ctx, repo.GetAgentVersionParams{
+		AgentID: request.AgentID,
+		Version: request.Version,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get version %d: %w", request.Version, err)
+	}
+
+	restoredAgent, err := qtx.UpdateAgent(ctx, repo.UpdateAgentParams{
+		ID:           request.AgentID,
+		Name:         version.Name,
+		Instructions: version.Instructions,
+		Tools:        version.Tools,
+		Settings:     version.Settings,
+		UserID:       request.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := recordAgentVersion(ctx, qtx, restoredAgent, &version.Version); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return toAgentResponse(restoredAgent), nil
+}
+
+func (s *agentService) UploadAgentDocument(ctx context.Context, request dto.UploadAgentDocumentRequest) (*dto.AgentDocumentResponse, error) {
+	agent, err := s.queries.GetAgent(ctx, repo.GetAgentParams{
+		AgentID: request.AgentID,
+		UserID:  request.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get agent: %w", err)
+	}
+	if err := authorizeOwned(request.UserID, agent.UserID, agent.OrgID, agent.MemberRole, authz.ResourceAgent, authz.ActionUpdate); err != nil {
+		return nil, err
+	}
+
+	contentType, err := knowledge.ContentTypeFor(request.FileName)
+	if err != nil {
+		return nil, err
+	}
+	document, err := s.knowledge.AddDocument(ctx, request.AgentID, request.FileName, contentType, request.Data)
+	if err != nil {
+		return nil, err
+	}
+	response := toAgentDocumentResponse(document)
+	return &response, nil
+}
+
+func (s *agentService) GetAgentDocuments(ctx context.Context, request dto.GetAgentDocumentsRequest) ([]dto.AgentDocumentResponse, error) {
+	agent, err := s.queries.GetAgent(ctx, repo.GetAgentParams{
+		AgentID: request.AgentID,
+		UserID:  request.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get agent: %w", err)
+	}
+	if err := authorize(request.UserID, agent.OrgID, agent.MemberRole, authz.ResourceAgent, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.GetAgentDocuments(ctx, request.AgentID)
+	if err != nil {
+		return nil, err
+	}
+	documents := make([]dto.AgentDocumentResponse, 0, len(rows))
+	for _, row := range rows {
+		documents = append(documents, toAgentDocumentResponse(row))
+	}
+	return documents, nil
+}
+
+func (s *agentService) DeleteAgentDocument(ctx context.Context, request dto.DeleteAgentDocumentRequest) error {
+	agent, err := s.queries.GetAgent(ctx, repo.GetAgentParams{
+		AgentID: request.AgentID,
+		UserID:  request.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get agent: %w", err)
+	}
+	if err := authorizeOwned(request.UserID, agent.UserID, agent.OrgID, agent.MemberRole, authz.ResourceAgent, authz.ActionUpdate); err != nil {
+		return err
+	}
+
+	deleted, err := s.queries.DeleteAgentDocument(ctx, repo.DeleteAgentDocumentParams{
+		ID:      request.ID,
+		AgentID: request.AgentID,
+	})
+	if err != nil {
+		return err
+	}
+	if deleted == 0 {
+		return fmt.Errorf("document not found")
+	}
+	return nil
+}
+
+func toAgentDocumentResponse(document repo.AgentDocument) dto.AgentDocumentResponse {
+	return dto.AgentDocumentResponse{
+		ID:          document.ID,
+		AgentID:     document.AgentID,
+		Name:        document.Name,
+		ContentType: document.ContentType,
+		SizeBytes:   document.SizeBytes,
+		ChunkCount:  document.ChunkCount,
+		CreatedAt:   document.CreatedAt,
+	}
+}
+
+func recordAgentVersion(ctx context.Context, queries *repo.Queries, agent repo.Agent, rolledBackFrom *int32) error {
+	if _, err := queries.CreateAgentVersion(ctx, repo.CreateAgentVersionParams{
+		AgentID:        agent.ID,
+		Name:           agent.Name,
+		Instructions:   agent.Instructions,
+		Tools:          agent.Tools,
+		Settings:       agent.Settings,
+		RolledBackFrom: rolledBackFrom,
+	}); err != nil {
+		return fmt.Errorf("failed to record agent version: %w", err)
+	}
+	return nil
+}
+
+func agentConfigChanged(previous repo.GetAgentRow, updated repo.Agent) bool {
+	return previous.Name != updated.Name ||
+		previous.Instructions != updated.Instructions ||
+		!bytes.Equal(previous.Tools, updated.Tools) ||
+		!bytes.Equal(previous.Settings, updated.Settings)
+}
+
+func toAgentVersionResponse(version repo.AgentVersion) dto.AgentVersionResponse {
+	return dto.AgentVersionResponse{
+		ID:             version.ID,
+		AgentID:        version.AgentID,
+		Version:        version.Version,
+		Name:           version.Name,
+		Instructions:   version.Instructions,
+		Tools:          parseAgentTools(version.Tools),
+		Settings:       parseAgentSettings(version.Settings),
+		RolledBackFrom: version.RolledBackFrom,
+		CreatedAt:      version.CreatedAt,
+	}
+}
+
 func toAgentResponse(agent repo.Agent) *dto.AgentResponse {
 	return &dto.AgentResponse{
 		ID:           agent.ID,
 		Name:         agent.Name,
 		UserID:       agent.UserID,
+		OrgID:        agent.OrgID,
 		Instructions: agent.Instructions,
+		Tools:        parseAgentTools(agent.Tools),
+		Settings:     parseAgentSettings(agent.Settings),
 		CreatedAt:    agent.CreatedAt,
 		UpdatedAt:    agent.UpdatedAt,
 	}
 }
+
+// parseAgentTools decodes tools for responses, with webhook headers redacted.
+func parseAgentTools(data []byte) []agentconfig.Tool {
+	tools, err := agentconfig.ParseTools(data)
+	if err != nil {
+		fmt.Printf("[ERROR] Failed to parse agent tools: %v\n", err)
+		return []agentconfig.Tool{}
+	}
+	return agentconfig.RedactTools(tools)
+}
+
+func parseAgentSettings(data []byte) agentconfig.Settings {
+	settings, err := agentconfig.ParseSettings(data)
+	if err != nil {
+		fmt.Printf("[ERROR] Failed to parse agent settings: %v\n", err)
+	}
+	return settings
+}
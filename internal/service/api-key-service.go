@@ -0,0 +1,116 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+	"github.com/rahulSailesh-shah/converSense/internal/dto"
+	"github.com/rahulSailesh-shah/converSense/pkg/apikey"
+)
+
+type APIKeyService interface {
+	CreateAPIKey(ctx context.Context, request dto.CreateAPIKeyRequest) (*dto.CreatedAPIKeyResponse, error)
+	GetAPIKeys(ctx context.Context, userID string) ([]dto.APIKeyResponse, error)
+	RevokeAPIKey(ctx context.Context, request dto.RevokeAPIKeyRequest) error
+	// Authenticate returns the active key matching a presented API key.
+	Authenticate(ctx context.Context, key string) (*repo.ApiKey, error)
+}
+
+type apiKeyService struct {
+	queries *repo.Queries
+}
+
+func NewAPIKeyService(queries *repo.Queries) APIKeyService {
+	return &apiKeyService{
+		queries: queries,
+	}
+}
+
+func (s *apiKeyService) CreateAPIKey(ctx context.Context, request dto.CreateAPIKeyRequest) (*dto.CreatedAPIKeyResponse, error) {
+	if err := apikey.ValidateScopes(request.Scopes); err != nil {
+		return nil, err
+	}
+	if request.ExpiresAt != nil && !request.ExpiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("expiry must be in the future")
+	}
+
+	key, prefix, hash, err := apikey.Generate()
+	if err != nil {
+		return nil, err
+	}
+	newKey, err := s.queries.CreateAPIKey(ctx, repo.CreateAPIKeyParams{
+		UserID:    request.UserID,
+		Name:      request.Name,
+		Prefix:    prefix,
+		KeyHash:   hash,
+		Scopes:    request.Scopes,
+		ExpiresAt: request.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &dto.CreatedAPIKeyResponse{
+		APIKeyResponse: toAPIKeyResponse(newKey),
+		Key:            key,
+	}, nil
+}
+
+func (s *apiKeyService) GetAPIKeys(ctx context.Context, userID string) ([]dto.APIKeyResponse, error) {
+	rows, err := s.queries.GetAPIKeys(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]dto.APIKeyResponse, 0, len(rows))
+	for _, row := range rows {
+		keys = append(keys, toAPIKeyResponse(row))
+	}
+	return keys, nil
+}
+
+func (s *apiKeyService) RevokeAPIKey(ctx context.Context, request dto.RevokeAPIKeyRequest) error {
+	revoked, err := s.queries.RevokeAPIKey(ctx, repo.RevokeAPIKeyParams{
+		ID:     request.ID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return err
+	}
+	if revoked == 0 {
+		return fmt.Errorf("API key not found")
+	}
+	return nil
+}
+
+func (s *apiKeyService) Authenticate(ctx context.Context, key string) (*repo.ApiKey, error) {
+	prefix, err := apikey.PrefixOf(key)
+	if err != nil {
+		return nil, err
+	}
+	apiKey, err := s.queries.GetAPIKeyByPrefix(ctx, prefix)
+	if err != nil || !apikey.Matches(key, apiKey.KeyHash) {
+		return nil, fmt.Errorf("invalid API key")
+	}
+	if apiKey.ExpiresAt != nil && !apiKey.ExpiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("API key expired")
+	}
+
+	if err := s.queries.TouchAPIKey(ctx, apiKey.ID); err != nil {
+		fmt.Printf("[ERROR] Failed to record API key use: %v\n", err)
+	}
+	return &apiKey, nil
+}
+
+func toAPIKeyResponse(key repo.ApiKey) dto.APIKeyResponse {
+	return dto.APIKeyResponse{
+		ID:         key.ID,
+		Name:       key.Name,
+		Prefix:     key.Prefix,
+		Scopes:     key.Scopes,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+		CreatedAt:  key.CreatedAt,
+	}
+}
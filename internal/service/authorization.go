@@ -0,0 +1,44 @@
+package service
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+	"github.com/rahulSailesh-shah/converSense/pkg/authz"
+)
+
+// authorize checks an action against the role the user holds in the
+// organization owning the resource, as loaded alongside it by the
+// membership-scoped queries.
+func authorize(userID string, orgID uuid.UUID, role string, resource authz.Resource, action authz.Action) error {
+	return authz.Authorize(authz.Subject{
+		UserID: userID,
+		OrgID:  orgID,
+		Role:   role,
+	}, resource, action)
+}
+
+// authorizeOwned is authorize for a single resource created by ownerID, so
+// members can change their own agents and meetings but not other members'.
+func authorizeOwned(userID string, ownerID string, orgID uuid.UUID, role string, resource authz.Resource, action authz.Action) error {
+	return authz.AuthorizeOn(authz.Subject{
+		UserID: userID,
+		OrgID:  orgID,
+		Role:   role,
+	}, resource, action, ownerID)
+}
+
+// authorizeInOrg looks up the user's role in the organization before
+// authorizing, for actions on resources that do not exist yet.
+func authorizeInOrg(ctx context.Context, queries *repo.Queries, userID string, orgID uuid.UUID, resource authz.Resource, action authz.Action) error {
+	var role string
+	member, err := queries.GetOrganizationMember(ctx, repo.GetOrganizationMemberParams{
+		OrgID:  orgID,
+		UserID: userID,
+	})
+	if err == nil {
+		role = member.Role
+	}
+	return authorize(userID, orgID, role, resource, action)
+}
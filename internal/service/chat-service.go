@@ -12,11 +12,12 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/google/uuid"
-	"github.com/openai/openai-go"
-	"github.com/openai/openai-go/option"
 	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+	"github.com/rahulSailesh-shah/converSense/pkg/authz"
 	"github.com/rahulSailesh-shah/converSense/pkg/config"
+	"github.com/rahulSailesh-shah/converSense/pkg/knowledge"
 	"github.com/rahulSailesh-shah/converSense/pkg/livekit"
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
 )
 
 type ChatService interface {
@@ -24,17 +25,21 @@ type ChatService interface {
 	GetChatHistory(ctx context.Context, meetingID uuid.UUID, userID string) ([]repo.MeetingChatMessages, error)
 }
 
+const maxChatKnowledgeChunks = 4
+
 type chatService struct {
 	queries         *repo.Queries
-	config          *config.OpenAIConfig
+	knowledge       *knowledge.Base
+	model           *llm.Model
 	awsConfig       *config.AWSConfig
 	transcriptCache sync.Map // Cache transcripts by meetingID
 }
 
-func NewChatService(queries *repo.Queries, config *config.OpenAIConfig, awsConfig *config.AWSConfig) ChatService {
+func NewChatService(queries *repo.Queries, knowledge *knowledge.Base, model *llm.Model, awsConfig *config.AWSConfig) ChatService {
 	return &chatService{
 		queries:         queries,
-		config:          config,
+		knowledge:       knowledge,
+		model:           model,
 		awsConfig:       awsConfig,
 		transcriptCache: sync.Map{},
 	}
@@ -48,6 +53,9 @@ func (s *chatService) Chat(ctx context.Context, meetingID uuid.UUID, userID stri
 	if err != nil {
 		return nil, fmt.Errorf("unauthorized: user does not have access to this meeting or meeting does not exist")
 	}
+	if err := authorize(userID, meeting.OrgID, meeting.MemberRole, authz.ResourceChat, authz.ActionCreate); err != nil {
+		return nil, err
+	}
 
 	_, err = s.queries.CreateChatMessage(ctx, repo.CreateChatMessageParams{
 		MeetingID: meetingID,
@@ -62,6 +70,7 @@ func (s *chatService) Chat(ctx context.Context, meetingID uuid.UUID, userID stri
 	const maxHistoryMessages = 20
 	historyRows, err := s.queries.GetRecentChatMessages(ctx, repo.GetRecentChatMessagesParams{
 		MeetingID: meetingID,
+		UserID:    userID,
 		Limit:     maxHistoryMessages,
 	})
 	if err != nil {
@@ -88,14 +97,29 @@ func (s *chatService) Chat(ctx context.Context, meetingID uuid.UUID, userID stri
 		}
 	}
 
-	client := openai.NewClient(
-		option.WithAPIKey(s.config.APIKey),
-		option.WithBaseURL(s.config.BaseURL),
-	)
+	// Answer with the instructions the meeting actually ran with, not
+	// whatever the agent has been edited to since.
+	agentInstructions := meeting.AgentInstructions
+	if meeting.AgentVersionID != nil {
+		agentVersion, err := s.queries.GetAgentVersionByID(ctx, *meeting.AgentVersionID)
+		if err != nil {
+			fmt.Printf("Failed to fetch agent version: %v\n", err)
+		} else {
+			agentInstructions = agentVersion.Instructions
+		}
+	}
+
+	var knowledgeContext string
+	chunks, err := s.knowledge.Search(ctx, meeting.AgentID, message, maxChatKnowledgeChunks)
+	if err != nil {
+		fmt.Printf("Failed to search knowledge base: %v\n", err)
+	} else if len(chunks) > 0 {
+		knowledgeContext = knowledge.FormatChunks(chunks)
+	}
 
 	systemPrompt := fmt.Sprintf(`
       You are an AI assistant helping the user revisit a recently completed meeting.
-      Below is a summary of the meeting, generated from the transcript:
+      Below is the meeting transcript. Each line is prefixed with its timestamp and the name of the participant who spoke:
 
       %s
 
@@ -111,52 +135,45 @@ func (s *chatService) Chat(ctx context.Context, meetingID uuid.UUID, userID stri
       If the summary does not contain enough information to answer a question, politely let the user know.
 
       Be concise, helpful, and focus on providing accurate information from the meeting and the ongoing conversation.
-      `, transcriptContext, meeting.AgentInstructions)
+      `, transcriptContext, agentInstructions)
+
+	if knowledgeContext != "" {
+		systemPrompt += fmt.Sprintf(`
+      The following excerpts from the agent's knowledge base documents may be relevant to the user's latest message. Each excerpt is labelled with its document name. Prefer them over general knowledge when they answer the question:
+
+      %s
+      `, knowledgeContext)
+	}
 
-	messages := []openai.ChatCompletionMessageParamUnion{
-		openai.SystemMessage(systemPrompt),
+	messages := []llm.Message{
+		llm.System(systemPrompt),
 	}
 
 	for _, msg := range history {
 		if msg.Role == "ai" {
-			messages = append(messages, openai.AssistantMessage(msg.Content))
+			messages = append(messages, llm.Assistant(msg.Content))
 		} else {
-			messages = append(messages, openai.UserMessage(msg.Content))
+			messages = append(messages, llm.User(msg.Content))
 		}
 	}
 
-	messages = append(messages, openai.UserMessage(message))
+	messages = append(messages, llm.User(message))
 
 	stream := make(chan string)
 
 	go func() {
 		defer close(stream)
 
-		openaiStream := client.Chat.Completions.NewStreaming(ctx, openai.ChatCompletionNewParams{
-			Model:    "z-ai/glm4.7",
-			Messages: messages,
-		})
-
-		var fullResponse strings.Builder
-
-		for openaiStream.Next() {
-			chunk := openaiStream.Current()
-			if len(chunk.Choices) > 0 {
-				text := chunk.Choices[0].Delta.Content
-				if text == "" {
-					continue
-				}
-				fullResponse.WriteString(text)
-				select {
-				case <-ctx.Done():
-					return
-				case stream <- text:
-				}
+		fullResponse, err := s.model.Stream(ctx, messages, func(text string) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case stream <- text:
+				return nil
 			}
-		}
-
-		if err := openaiStream.Err(); err != nil {
-			fmt.Printf("Error in openai stream: %v\n", err)
+		})
+		if err != nil {
+			fmt.Printf("Error in chat stream: %v\n", err)
 			return
 		}
 
@@ -164,7 +181,7 @@ func (s *chatService) Chat(ctx context.Context, meetingID uuid.UUID, userID stri
 			MeetingID: meetingID,
 			UserID:    "ai",
 			Role:      "ai",
-			Content:   fullResponse.String(),
+			Content:   fullResponse,
 		})
 		if err != nil {
 			fmt.Printf("Failed to save AI message: %v\n", err)
@@ -175,16 +192,21 @@ func (s *chatService) Chat(ctx context.Context, meetingID uuid.UUID, userID stri
 }
 
 func (s *chatService) GetChatHistory(ctx context.Context, meetingID uuid.UUID, userID string) ([]repo.MeetingChatMessages, error) {
-	// Authorization check
-	_, err := s.queries.GetMeeting(ctx, repo.GetMeetingParams{
+	meeting, err := s.queries.GetMeeting(ctx, repo.GetMeetingParams{
 		ID:     meetingID,
 		UserID: userID,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("unauthorized")
 	}
+	if err := authorize(userID, meeting.OrgID, meeting.MemberRole, authz.ResourceChat, authz.ActionRead); err != nil {
+		return nil, err
+	}
 
-	return s.queries.GetChatMessages(ctx, meetingID)
+	return s.queries.GetChatMessages(ctx, repo.GetChatMessagesParams{
+		MeetingID: meetingID,
+		UserID:    userID,
+	})
 }
 
 func (s *chatService) fetchTranscript(ctx context.Context, s3URL string) (*livekit.SessionTranscript, error) {
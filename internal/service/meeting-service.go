@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -14,9 +15,14 @@ import (
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
 	"github.com/rahulSailesh-shah/converSense/internal/dto"
+	"github.com/rahulSailesh-shah/converSense/pkg/agentconfig"
+	"github.com/rahulSailesh-shah/converSense/pkg/authz"
 	"github.com/rahulSailesh-shah/converSense/pkg/config"
 	"github.com/rahulSailesh-shah/converSense/pkg/inngest"
+	"github.com/rahulSailesh-shah/converSense/pkg/knowledge"
 	"github.com/rahulSailesh-shah/converSense/pkg/livekit"
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
+	"github.com/rahulSailesh-shah/converSense/pkg/webhook"
 )
 
 type MeetingService interface {
@@ -27,43 +33,88 @@ type MeetingService interface {
 	DeleteMeeting(ctx context.Context, request dto.DeleteMeetingRequest) error
 	StartMeeting(ctx context.Context, request dto.StartMeetingRequest) (string, error)
 	GetPreSignedRecordingURL(ctx context.Context, request dto.GetPreSignedRecordingURLRequest) (string, error)
+	InviteParticipant(ctx context.Context, request dto.InviteParticipantRequest) (*dto.ParticipantResponse, error)
+	GetParticipants(ctx context.Context, request dto.GetParticipantsRequest) ([]dto.ParticipantResponse, error)
+	RemoveParticipant(ctx context.Context, request dto.RemoveParticipantRequest) error
+	JoinMeeting(ctx context.Context, request dto.JoinMeetingRequest) (string, error)
+	GetMeetingNotes(ctx context.Context, request dto.GetMeetingNotesRequest) ([]dto.MeetingNoteResponse, error)
+	GetActionItems(ctx context.Context, request dto.GetMeetingItemsRequest) ([]dto.MeetingActionItemResponse, error)
+	UpdateActionItem(ctx context.Context, request dto.UpdateActionItemRequest) (*dto.MeetingActionItemResponse, error)
+	GetDecisions(ctx context.Context, request dto.GetMeetingItemsRequest) ([]dto.MeetingDecisionResponse, error)
+	GetOpenQuestions(ctx context.Context, request dto.GetMeetingItemsRequest) ([]dto.MeetingQuestionResponse, error)
+	ReprocessMeeting(ctx context.Context, request dto.ReprocessMeetingRequest) error
+	GetSummaries(ctx context.Context, request dto.GetMeetingItemsRequest) ([]dto.MeetingSummaryResponse, error)
+	ActivateSummary(ctx context.Context, request dto.ActivateSummaryRequest) (*dto.MeetingSummaryResponse, error)
+	GetSentimentReport(ctx context.Context, request dto.GetMeetingItemsRequest) (*dto.MeetingSentimentReportResponse, error)
+	StopMeeting(ctx context.Context, request dto.StopMeetingRequest) error
+	GetLiveSession(ctx context.Context, request dto.GetMeetingRequest) (*dto.LiveSessionResponse, error)
+	ReconcileActiveMeetings(ctx context.Context) error
+	Shutdown(ctx context.Context) error
 }
 
 type meetingService struct {
-	queries *repo.Queries
-	inngest *inngest.Inngest
-	db      *pgxpool.Pool
+	queries  *repo.Queries
+	inngest  *inngest.Inngest
+	db       *pgxpool.Pool
+	sessions *livekit.SessionRegistry
+	// knowledge backs the agents' knowledge base search tool
+	knowledge *knowledge.Base
 
 	// LiveKit configuration
-	lkConfig     *config.LiveKitConfig
-	geminiConfig *config.GeminiConfig
-	awsConfig    *config.AWSConfig
+	lkConfig       *config.LiveKitConfig
+	geminiConfig   *config.GeminiConfig
+	awsConfig      *config.AWSConfig
+	realtimeConfig *config.RealtimeConfig
+	llmConfig      *config.LLMConfig
+	sentimentModel *llm.Model
+	// summaryModel keeps the rolling summary of live meetings
+	summaryModel *llm.Model
 }
 
 func NewMeetingService(
 	db *pgxpool.Pool,
 	queries *repo.Queries,
 	inngest *inngest.Inngest,
+	sessions *livekit.SessionRegistry,
+	knowledge *knowledge.Base,
 	lkConfig *config.LiveKitConfig,
 	geminiConfig *config.GeminiConfig,
 	awsConfig *config.AWSConfig,
+	realtimeConfig *config.RealtimeConfig,
+	llmConfig *config.LLMConfig,
+	sentimentModel *llm.Model,
+	summaryModel *llm.Model,
 ) MeetingService {
 	return &meetingService{
-		db:           db,
-		queries:      queries,
-		lkConfig:     lkConfig,
-		geminiConfig: geminiConfig,
-		awsConfig:    awsConfig,
-		inngest:      inngest,
+		db:             db,
+		queries:        queries,
+		lkConfig:       lkConfig,
+		geminiConfig:   geminiConfig,
+		awsConfig:      awsConfig,
+		realtimeConfig: realtimeConfig,
+		llmConfig:      llmConfig,
+		sentimentModel: sentimentModel,
+		summaryModel:   summaryModel,
+		inngest:        inngest,
+		sessions:       sessions,
+		knowledge:      knowledge,
 	}
 }
 
 func (s *meetingService) CreateMeeting(ctx context.Context,
 	request dto.CreateMeetingRequest) (*dto.MeetingResponse, error) {
+	if err := authorizeInOrg(ctx, s.queries, request.UserID, request.OrgID, authz.ResourceMeeting, authz.ActionCreate); err != nil {
+		return nil, err
+	}
+	if err := s.requireOrgAgent(ctx, request.OrgID, request.AgentID, request.UserID); err != nil {
+		return nil, err
+	}
+
 	newMeeting, err := s.queries.CreateMeeting(ctx, repo.CreateMeetingParams{
 		Name:    request.Name,
 		UserID:  request.UserID,
 		AgentID: request.AgentID,
+		OrgID:   request.OrgID,
 	})
 	if err != nil {
 		return nil, err
@@ -72,6 +123,28 @@ func (s *meetingService) CreateMeeting(ctx context.Context,
 }
 
 func (s *meetingService) UpdateMeeting(ctx context.Context,
+	request dto.UpdateMeetingRequest) (*dto.MeetingResponse, error) {
+	meeting, err := s.queries.GetMeeting(ctx, repo.GetMeetingParams{
+		ID:     request.ID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("-- failed to get meeting --: %w", err)
+	}
+	if err := authorizeOwned(request.UserID, meeting.UserID, meeting.OrgID, meeting.MemberRole, authz.ResourceMeeting, authz.ActionUpdate); err != nil {
+		return nil, err
+	}
+	if request.AgentID != uuid.Nil && request.AgentID != meeting.AgentID {
+		if err := s.requireOrgAgent(ctx, meeting.OrgID, request.AgentID, request.UserID); err != nil {
+			return nil, err
+		}
+	}
+	return s.updateMeeting(ctx, request)
+}
+
+// updateMeeting applies an update on behalf of a member of the meeting's
+// organization without checking their role. Callers authorize first.
+func (s *meetingService) updateMeeting(ctx context.Context,
 	request dto.UpdateMeetingRequest) (*dto.MeetingResponse, error) {
 	currentMeeting, err := s.queries.GetMeeting(ctx, repo.GetMeetingParams{
 		ID:     request.ID,
@@ -121,7 +194,7 @@ func (s *meetingService) UpdateMeeting(ctx context.Context,
 
 	updatedMeeting, err := s.queries.UpdateMeeting(ctx, repo.UpdateMeetingParams{
 		ID:            currentMeeting.ID,
-		UserID:        currentMeeting.UserID,
+		UserID:        request.UserID,
 		Name:          currentMeeting.Name,
 		AgentID:       currentMeeting.AgentID,
 		Status:        currentMeeting.Status,
@@ -141,8 +214,9 @@ func (s *meetingService) UpdateMeeting(ctx context.Context,
 func (s *meetingService) GetMeetings(ctx context.Context,
 	request dto.GetMeetingsRequest) (*dto.PaginatedMeetingsResponse, error) {
 	rows, err := s.queries.GetMeetings(ctx, repo.GetMeetingsParams{
+		OrgID:   request.OrgID,
 		UserID:  request.UserID,
-		Column2: request.Search,
+		Column3: request.Search,
 		Limit:   request.Limit,
 		Offset:  request.Offset,
 	})
@@ -159,6 +233,7 @@ func (s *meetingService) GetMeetings(ctx context.Context,
 		meetings = append(meetings, dto.MeetingResponse{
 			ID:        row.ID,
 			UserID:    row.UserID,
+			OrgID:     row.OrgID,
 			Name:      row.Name,
 			AgentID:   row.AgentID,
 			Status:    row.Status,
@@ -170,6 +245,7 @@ func (s *meetingService) GetMeetings(ctx context.Context,
 				Name:         row.AgentName,
 				Instructions: row.AgentInstructions,
 			},
+			Processing: toProcessingStatus(row.ProcessingStatus, row.ProcessingStep, row.ProcessingError, row.ProcessingAttempts),
 		})
 	}
 
@@ -187,7 +263,21 @@ func (s *meetingService) GetMeetings(ctx context.Context,
 }
 
 func (s *meetingService) DeleteMeeting(ctx context.Context, request dto.DeleteMeetingRequest) error {
-	err := s.queries.DeleteMeeting(ctx, request.ID)
+	meeting, err := s.queries.GetMeeting(ctx, repo.GetMeetingParams{
+		ID:     request.ID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get meeting: %w", err)
+	}
+	if err := authorizeOwned(request.UserID, meeting.UserID, meeting.OrgID, meeting.MemberRole, authz.ResourceMeeting, authz.ActionDelete); err != nil {
+		return err
+	}
+
+	err = s.queries.DeleteMeeting(ctx, repo.DeleteMeetingParams{
+		ID:     request.ID,
+		UserID: request.UserID,
+	})
 	if err != nil {
 		return err
 	}
@@ -202,6 +292,9 @@ func (s *meetingService) GetMeeting(ctx context.Context, request dto.GetMeetingR
 	if err != nil {
 		return nil, err
 	}
+	if err := authorize(request.UserID, meeting.OrgID, meeting.MemberRole, authz.ResourceMeeting, authz.ActionRead); err != nil {
+		return nil, err
+	}
 	return toMeetingAgentResponse(meeting), nil
 }
 
@@ -213,6 +306,9 @@ func (s *meetingService) StartMeeting(ctx context.Context, request dto.StartMeet
 	if err != nil {
 		return "", fmt.Errorf("failed to get meeting: %w", err)
 	}
+	if err := authorizeOwned(request.UserID, meeting.UserID, meeting.OrgID, meeting.MemberRole, authz.ResourceMeeting, authz.ActionUpdate); err != nil {
+		return "", err
+	}
 
 	if meeting.Status != "upcoming" {
 		return "", fmt.Errorf("meeting is not in upcoming state")
@@ -223,12 +319,38 @@ func (s *meetingService) StartMeeting(ctx context.Context, request dto.StartMeet
 		return "", fmt.Errorf("user not found")
 	}
 
+	// Run the meeting on the agent's latest version and pin it, so the
+	// meeting can later be traced back to the exact configuration it used.
+	agentVersion, err := s.queries.GetLatestAgentVersion(ctx, meeting.AgentID)
+	if err != nil {
+		return "", fmt.Errorf("failed to get agent version: %w", err)
+	}
+	meeting.AgentName = agentVersion.Name
+	meeting.AgentInstructions = agentVersion.Instructions
+	meeting.AgentTools = agentVersion.Tools
+	meeting.AgentSettings = agentVersion.Settings
+	meeting.AgentVersionID = &agentVersion.ID
+
+	agentTools, err := agentconfig.ParseTools(meeting.AgentTools)
+	if err != nil {
+		return "", err
+	}
+	agentSettings, err := agentconfig.ParseSettings(meeting.AgentSettings)
+	if err != nil {
+		return "", err
+	}
+
 	session := livekit.NewLiveKitSession(
 		&meeting,
 		&userDetails,
 		s.lkConfig,
 		s.geminiConfig,
 		s.awsConfig,
+		s.sentimentModel,
+		s.summaryModel,
+		livekit.RealtimeModelTypeFor(agentSettings, livekit.RealtimeModelType(s.realtimeConfig.Provider)),
+		livekit.NewAgentToolRegistry(s.queries, s.knowledge, s.inngest, agentTools),
+		agentSettings,
 		livekit.SessionCallbacks{
 			OnMeetingEnd: func(meetingID string, recordingURL string, transcriptURL string, err error) {
 				s.onMeetingEnd(meetingID, recordingURL, transcriptURL, err)
@@ -236,11 +358,15 @@ func (s *meetingService) StartMeeting(ctx context.Context, request dto.StartMeet
 		},
 	)
 
+	if err := s.sessions.Add(session); err != nil {
+		return "", err
+	}
 	if err := session.Start(); err != nil {
+		s.sessions.Remove(session.MeetingID())
 		return "", fmt.Errorf("failed to start session: %w", err)
 	}
 	startTime := time.Now()
-	_, err = s.UpdateMeeting(ctx, dto.UpdateMeetingRequest{
+	startedMeeting, err := s.updateMeeting(ctx, dto.UpdateMeetingRequest{
 		ID:        request.ID,
 		UserID:    request.UserID,
 		Status:    "active",
@@ -250,6 +376,13 @@ func (s *meetingService) StartMeeting(ctx context.Context, request dto.StartMeet
 		session.Stop()
 		return "", fmt.Errorf("failed to update meeting: %w", err)
 	}
+	if err := s.queries.SetMeetingAgentVersion(ctx, repo.SetMeetingAgentVersionParams{
+		ID:             request.ID,
+		AgentVersionID: meeting.AgentVersionID,
+	}); err != nil {
+		fmt.Printf("[ERROR] Failed to pin agent version for meeting %s: %v\n", request.ID, err)
+	}
+	s.publishMeetingEvent(ctx, webhook.EventMeetingStarted, startedMeeting)
 	token, err := session.GenerateUserToken()
 	if err != nil {
 		session.Stop()
@@ -268,6 +401,9 @@ func (s *meetingService) GetPreSignedRecordingURL(ctx context.Context,
 	if err != nil {
 		return "", fmt.Errorf("failed to get meeting: %w", err)
 	}
+	if err := authorize(request.UserID, meeting.OrgID, meeting.MemberRole, authz.ResourceRecording, authz.ActionRead); err != nil {
+		return "", err
+	}
 
 	if meeting.Status != "completed" {
 		return "", fmt.Errorf("meeting not completed yet")
@@ -316,46 +452,655 @@ func (s *meetingService) GetPreSignedRecordingURL(ctx context.Context,
 	return presignReq.URL, nil
 }
 
+func (s *meetingService) InviteParticipant(ctx context.Context,
+	request dto.InviteParticipantRequest) (*dto.ParticipantResponse, error) {
+	meeting, err := s.queries.GetMeeting(ctx, repo.GetMeetingParams{
+		ID:     request.MeetingID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get meeting: %w", err)
+	}
+	if err := authorizeOwned(request.UserID, meeting.UserID, meeting.OrgID, meeting.MemberRole, authz.ResourceMeeting, authz.ActionUpdate); err != nil {
+		return nil, err
+	}
+	if meeting.Status == "completed" {
+		return nil, fmt.Errorf("meeting already completed")
+	}
+
+	var invitee repo.User
+	if request.InviteeID != "" {
+		invitee, err = s.queries.GetUserByID(ctx, request.InviteeID)
+	} else {
+		invitee, err = s.queries.GetUserByEmail(ctx, request.Email)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+	if invitee.ID == meeting.UserID {
+		return nil, fmt.Errorf("meeting owner cannot be invited")
+	}
+
+	participant, err := s.queries.CreateMeetingParticipant(ctx, repo.CreateMeetingParticipantParams{
+		MeetingID: meeting.ID,
+		UserID:    invitee.ID,
+		InvitedBy: request.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to invite participant: %w", err)
+	}
+
+	return &dto.ParticipantResponse{
+		ID:        participant.ID,
+		MeetingID: participant.MeetingID,
+		UserID:    participant.UserID,
+		Name:      invitee.Name,
+		Email:     invitee.Email,
+		InvitedBy: participant.InvitedBy,
+		Status:    participant.Status,
+		CreatedAt: participant.CreatedAt,
+		UpdatedAt: participant.UpdatedAt,
+	}, nil
+}
+
+func (s *meetingService) GetParticipants(ctx context.Context,
+	request dto.GetParticipantsRequest) ([]dto.ParticipantResponse, error) {
+	meeting, err := s.queries.GetMeeting(ctx, repo.GetMeetingParams{
+		ID:     request.MeetingID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get meeting: %w", err)
+	}
+	if err := authorize(request.UserID, meeting.OrgID, meeting.MemberRole, authz.ResourceMeeting, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.GetMeetingParticipants(ctx, request.MeetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	participants := make([]dto.ParticipantResponse, 0, len(rows))
+	for _, row := range rows {
+		participants = append(participants, dto.ParticipantResponse{
+			ID:        row.ID,
+			MeetingID: row.MeetingID,
+			UserID:    row.UserID,
+			Name:      row.UserName,
+			Email:     row.UserEmail,
+			InvitedBy: row.InvitedBy,
+			Status:    row.Status,
+			CreatedAt: row.CreatedAt,
+			UpdatedAt: row.UpdatedAt,
+		})
+	}
+	return participants, nil
+}
+
+// GetMeetingNotes returns the notes and reminders the agent saved during the
+// meeting.
+func (s *meetingService) GetMeetingNotes(ctx context.Context,
+	request dto.GetMeetingNotesRequest) ([]dto.MeetingNoteResponse, error) {
+	meeting, err := s.queries.GetMeeting(ctx, repo.GetMeetingParams{
+		ID:     request.MeetingID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get meeting: %w", err)
+	}
+	if err := authorize(request.UserID, meeting.OrgID, meeting.MemberRole, authz.ResourceMeeting, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.GetMeetingNotes(ctx, request.MeetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	notes := make([]dto.MeetingNoteResponse, 0, len(rows))
+	for _, row := range rows {
+		notes = append(notes, dto.MeetingNoteResponse{
+			ID:        row.ID,
+			MeetingID: row.MeetingID,
+			Kind:      row.Kind,
+			Content:   row.Content,
+			Author:    row.Author,
+			RemindAt:  row.RemindAt,
+			CreatedAt: row.CreatedAt,
+		})
+	}
+	return notes, nil
+}
+
+// GetActionItems returns the meeting's action items, both those the agent
+// captured live and those extracted by post-processing.
+func (s *meetingService) GetActionItems(ctx context.Context,
+	request dto.GetMeetingItemsRequest) ([]dto.MeetingActionItemResponse, error) {
+	if err := s.authorizeMeeting(ctx, request.MeetingID, request.UserID, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.GetMeetingActionItems(ctx, request.MeetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]dto.MeetingActionItemResponse, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, toActionItemResponse(row))
+	}
+	return items, nil
+}
+
+func (s *meetingService) UpdateActionItem(ctx context.Context,
+	request dto.UpdateActionItemRequest) (*dto.MeetingActionItemResponse, error) {
+	if err := s.authorizeMeeting(ctx, request.MeetingID, request.UserID, authz.ActionUpdate); err != nil {
+		return nil, err
+	}
+
+	item, err := s.queries.UpdateMeetingActionItemStatus(ctx, repo.UpdateMeetingActionItemStatusParams{
+		ID:        request.ItemID,
+		MeetingID: request.MeetingID,
+		Status:    request.Status,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("action item not found")
+	}
+	response := toActionItemResponse(item)
+	return &response, nil
+}
+
+func (s *meetingService) GetDecisions(ctx context.Context,
+	request dto.GetMeetingItemsRequest) ([]dto.MeetingDecisionResponse, error) {
+	if err := s.authorizeMeeting(ctx, request.MeetingID, request.UserID, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.GetMeetingDecisions(ctx, request.MeetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := make([]dto.MeetingDecisionResponse, 0, len(rows))
+	for _, row := range rows {
+		decisions = append(decisions, dto.MeetingDecisionResponse{
+			ID:              row.ID,
+			MeetingID:       row.MeetingID,
+			Description:     row.Description,
+			MadeBy:          row.MadeBy,
+			SourceTimestamp: row.SourceTimestamp,
+			CreatedAt:       row.CreatedAt,
+		})
+	}
+	return decisions, nil
+}
+
+func (s *meetingService) GetOpenQuestions(ctx context.Context,
+	request dto.GetMeetingItemsRequest) ([]dto.MeetingQuestionResponse, error) {
+	if err := s.authorizeMeeting(ctx, request.MeetingID, request.UserID, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.GetMeetingQuestions(ctx, request.MeetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	questions := make([]dto.MeetingQuestionResponse, 0, len(rows))
+	for _, row := range rows {
+		questions = append(questions, dto.MeetingQuestionResponse{
+			ID:              row.ID,
+			MeetingID:       row.MeetingID,
+			Question:        row.Question,
+			AskedBy:         row.AskedBy,
+			SourceTimestamp: row.SourceTimestamp,
+			CreatedAt:       row.CreatedAt,
+		})
+	}
+	return questions, nil
+}
+
+// ReprocessMeeting queues post-processing again for a completed meeting, for
+// example to redo a failed or weak summary.
+func (s *meetingService) ReprocessMeeting(ctx context.Context, request dto.ReprocessMeetingRequest) error {
+	meeting, err := s.queries.GetMeeting(ctx, repo.GetMeetingParams{
+		ID:     request.MeetingID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get meeting: %w", err)
+	}
+	if err := authorizeOwned(request.UserID, meeting.UserID, meeting.OrgID, meeting.MemberRole, authz.ResourceMeeting, authz.ActionUpdate); err != nil {
+		return err
+	}
+	if meeting.Status != "completed" || meeting.TranscriptUrl == nil {
+		return fmt.Errorf("only completed meetings with a transcript can be reprocessed")
+	}
+	if request.Template != "" {
+		if _, ok := agentconfig.GetSummaryTemplate(request.Template); !ok {
+			return fmt.Errorf("unknown summary template %q", request.Template)
+		}
+	}
+	if request.Model != "" && !s.llmConfig.Summary.Allows(request.Model) {
+		return fmt.Errorf("summary model %q is not allowed", request.Model)
+	}
+
+	return s.inngest.ReprocessMeeting(ctx, meeting.ID.String(), inngest.PostProcessOptions{
+		Steps:       request.Steps,
+		Template:    request.Template,
+		Model:       request.Model,
+		RequestedBy: request.UserID,
+	})
+}
+
+// GetSummaries returns every summary generated for the meeting, newest first.
+func (s *meetingService) GetSummaries(ctx context.Context,
+	request dto.GetMeetingItemsRequest) ([]dto.MeetingSummaryResponse, error) {
+	if err := s.authorizeMeeting(ctx, request.MeetingID, request.UserID, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.GetMeetingSummaries(ctx, request.MeetingID)
+	if err != nil {
+		return nil, err
+	}
+
+	summaries := make([]dto.MeetingSummaryResponse, 0, len(rows))
+	for _, row := range rows {
+		summaries = append(summaries, toSummaryResponse(row))
+	}
+	return summaries, nil
+}
+
+// ActivateSummary makes an earlier summary the one shown on the meeting.
+func (s *meetingService) ActivateSummary(ctx context.Context,
+	request dto.ActivateSummaryRequest) (*dto.MeetingSummaryResponse, error) {
+	if err := s.authorizeMeeting(ctx, request.MeetingID, request.UserID, authz.ActionUpdate); err != nil {
+		return nil, err
+	}
+
+	summary, err := s.queries.GetMeetingSummary(ctx, repo.GetMeetingSummaryParams{
+		ID:        request.SummaryID,
+		MeetingID: request.MeetingID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("summary not found")
+	}
+
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	if _, err := qtx.ActivateMeetingSummary(ctx, repo.ActivateMeetingSummaryParams{
+		ID:        summary.ID,
+		MeetingID: summary.MeetingID,
+	}); err != nil {
+		return nil, err
+	}
+	if err := qtx.SetMeetingSummary(ctx, repo.SetMeetingSummaryParams{
+		ID:      summary.MeetingID,
+		Summary: &summary.Content,
+	}); err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	summary.Active = true
+	response := toSummaryResponse(summary)
+	return &response, nil
+}
+
+// authorizeMeeting checks an action on a meeting against the user's role in
+// the organization owning it.
+func (s *meetingService) authorizeMeeting(ctx context.Context, meetingID uuid.UUID, userID string, action authz.Action) error {
+	meeting, err := s.queries.GetMeeting(ctx, repo.GetMeetingParams{
+		ID:     meetingID,
+		UserID: userID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get meeting: %w", err)
+	}
+	return authorizeOwned(userID, meeting.UserID, meeting.OrgID, meeting.MemberRole, authz.ResourceMeeting, action)
+}
+
+func toActionItemResponse(item repo.MeetingActionItem) dto.MeetingActionItemResponse {
+	return dto.MeetingActionItemResponse{
+		ID:              item.ID,
+		MeetingID:       item.MeetingID,
+		Description:     item.Description,
+		Owner:           item.Owner,
+		DueDate:         item.DueDate,
+		SourceTimestamp: item.SourceTimestamp,
+		Source:          item.Source,
+		Status:          item.Status,
+		CreatedBy:       item.CreatedBy,
+		CreatedAt:       item.CreatedAt,
+		UpdatedAt:       item.UpdatedAt,
+	}
+}
+
+// GetSentimentReport returns the sentiment report built after the meeting.
+func (s *meetingService) GetSentimentReport(ctx context.Context,
+	request dto.GetMeetingItemsRequest) (*dto.MeetingSentimentReportResponse, error) {
+	if err := s.authorizeMeeting(ctx, request.MeetingID, request.UserID, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	report, err := s.queries.GetMeetingSentimentReport(ctx, request.MeetingID)
+	if err != nil {
+		return nil, fmt.Errorf("sentiment report not found")
+	}
+	var participants []dto.ParticipantSentiment
+	if err := json.Unmarshal(report.Participants, &participants); err != nil {
+		return nil, fmt.Errorf("failed to parse sentiment report: %w", err)
+	}
+
+	return &dto.MeetingSentimentReportResponse{
+		MeetingID:    report.MeetingID,
+		Overall:      report.Overall,
+		Score:        report.Score,
+		Summary:      report.Summary,
+		Participants: participants,
+		Model:        report.Model,
+		CreatedAt:    report.CreatedAt,
+		UpdatedAt:    report.UpdatedAt,
+	}, nil
+}
+
+func toSummaryResponse(summary repo.MeetingSummary) dto.MeetingSummaryResponse {
+	return dto.MeetingSummaryResponse{
+		ID:        summary.ID,
+		MeetingID: summary.MeetingID,
+		Version:   summary.Version,
+		Content:   summary.Content,
+		Template:  summary.Template,
+		Model:     summary.Model,
+		Active:    summary.Active,
+		CreatedBy: summary.CreatedBy,
+		CreatedAt: summary.CreatedAt,
+	}
+}
+
+func (s *meetingService) RemoveParticipant(ctx context.Context, request dto.RemoveParticipantRequest) error {
+	meeting, err := s.queries.GetMeeting(ctx, repo.GetMeetingParams{
+		ID:     request.MeetingID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get meeting: %w", err)
+	}
+	if err := authorizeOwned(request.UserID, meeting.UserID, meeting.OrgID, meeting.MemberRole, authz.ResourceMeeting, authz.ActionUpdate); err != nil {
+		return err
+	}
+
+	return s.queries.DeleteMeetingParticipant(ctx, repo.DeleteMeetingParticipantParams{
+		MeetingID: request.MeetingID,
+		UserID:    request.InviteeID,
+	})
+}
+
+// JoinMeeting issues a LiveKit token for the owner, an invited participant or
+// a member of the meeting's organization once the meeting has started.
+func (s *meetingService) JoinMeeting(ctx context.Context, request dto.JoinMeetingRequest) (string, error) {
+	meeting, err := s.queries.GetMeetingByID(ctx, repo.GetMeetingByIDParams{
+		ID:     request.ID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get meeting: %w", err)
+	}
+
+	// Only the owner and invited participants may join; read access to the
+	// organization's meetings is not enough to enter a live one.
+	role := "owner"
+	if meeting.UserID != request.UserID {
+		if _, err := s.queries.GetMeetingParticipant(ctx, repo.GetMeetingParticipantParams{
+			MeetingID: meeting.ID,
+			UserID:    request.UserID,
+		}); err != nil {
+			return "", fmt.Errorf("user is not invited to this meeting")
+		}
+		role = "participant"
+	}
+
+	if meeting.Status != "active" {
+		return "", fmt.Errorf("meeting is not active")
+	}
+
+	userDetails, err := s.queries.GetUserByID(ctx, request.UserID)
+	if err != nil {
+		return "", fmt.Errorf("user not found")
+	}
+
+	token, err := livekit.GenerateParticipantToken(s.lkConfig, meeting.ID.String(), livekit.ParticipantMetadata{
+		UserID: userDetails.ID,
+		Name:   userDetails.Name,
+		Role:   role,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	if role == "participant" {
+		if _, err := s.queries.UpdateMeetingParticipantStatus(ctx, repo.UpdateMeetingParticipantStatusParams{
+			MeetingID: meeting.ID,
+			UserID:    request.UserID,
+			Status:    "joined",
+		}); err != nil {
+			fmt.Printf("[ERROR] Failed to update participant status: %v\n", err)
+		}
+	}
+
+	return token, nil
+}
+
+func (s *meetingService) StopMeeting(ctx context.Context, request dto.StopMeetingRequest) error {
+	meeting, err := s.queries.GetMeeting(ctx, repo.GetMeetingParams{
+		ID:     request.ID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get meeting: %w", err)
+	}
+	if err := authorizeOwned(request.UserID, meeting.UserID, meeting.OrgID, meeting.MemberRole, authz.ResourceMeeting, authz.ActionUpdate); err != nil {
+		return err
+	}
+
+	session, ok := s.sessions.Get(meeting.ID.String())
+	if !ok {
+		if meeting.Status != "active" {
+			return fmt.Errorf("meeting is not live")
+		}
+		// Marked active but not running here: the session was lost.
+		return s.reconcileMeeting(ctx, meeting.ID, meeting.UserID)
+	}
+
+	if err := session.Stop(); err != nil {
+		return fmt.Errorf("failed to stop meeting: %w", err)
+	}
+	return nil
+}
+
+func (s *meetingService) GetLiveSession(ctx context.Context,
+	request dto.GetMeetingRequest) (*dto.LiveSessionResponse, error) {
+	meeting, err := s.queries.GetMeeting(ctx, repo.GetMeetingParams{
+		ID:     request.ID,
+		UserID: request.UserID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get meeting: %w", err)
+	}
+	if err := authorize(request.UserID, meeting.OrgID, meeting.MemberRole, authz.ResourceMeeting, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	response := &dto.LiveSessionResponse{
+		MeetingID: meeting.ID,
+		Status:    meeting.Status,
+	}
+	session, ok := s.sessions.Get(meeting.ID.String())
+	if !ok {
+		return response, nil
+	}
+
+	health := session.Health()
+	response.Live = !health.Stopped
+	response.RealtimeModel = string(health.ModelType)
+	response.ConnectionState = health.ConnectionState
+	response.Participants = health.Participants
+	response.Recording = health.Recording
+	if !health.StartedAt.IsZero() {
+		response.StartedAt = &health.StartedAt
+		response.UptimeSeconds = int64(time.Since(health.StartedAt).Seconds())
+	}
+	return response, nil
+}
+
+// ReconcileActiveMeetings closes out meetings left "active" without a live
+// session in this process, typically after a restart.
+func (s *meetingService) ReconcileActiveMeetings(ctx context.Context) error {
+	meetings, err := s.queries.GetMeetingsByStatus(ctx, "active")
+	if err != nil {
+		return fmt.Errorf("failed to list active meetings: %w", err)
+	}
+
+	for _, meeting := range meetings {
+		if _, ok := s.sessions.Get(meeting.ID.String()); ok {
+			continue
+		}
+		if err := s.reconcileMeeting(ctx, meeting.ID, meeting.UserID); err != nil {
+			fmt.Printf("[ERROR] Failed to reconcile meeting %s: %v\n", meeting.ID, err)
+		}
+	}
+	return nil
+}
+
+// Shutdown stops every live session owned by this process so their
+// recordings and transcripts are persisted and post-processing is enqueued.
+// It gives up waiting once ctx expires.
+func (s *meetingService) Shutdown(ctx context.Context) error {
+	sessions := s.sessions.Close()
+	if len(sessions) == 0 {
+		return nil
+	}
+	fmt.Println("[-] Draining live meeting sessions", "count", len(sessions))
+
+	var wg sync.WaitGroup
+	for _, session := range sessions {
+		wg.Add(1)
+		go func(session *livekit.LiveKitSession) {
+			defer wg.Done()
+			if err := session.StopWithContext(ctx); err != nil {
+				fmt.Printf("[ERROR] Failed to stop meeting %s: %v\n", session.MeetingID(), err)
+			}
+		}(session)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("timed out draining %d meeting sessions: %w", len(sessions), ctx.Err())
+	}
+}
+
+func (s *meetingService) reconcileMeeting(ctx context.Context, meetingID uuid.UUID, userID string) error {
+	fmt.Println("[-] Reconciling orphaned meeting", "meetingID", meetingID)
+
+	recordingURL, err := livekit.CleanupOrphanedRoom(ctx, s.lkConfig, s.awsConfig, userID, meetingID.String())
+	if err != nil {
+		fmt.Printf("[ERROR] Failed to clean up room for meeting %s: %v\n", meetingID, err)
+	}
+
+	return s.completeMeeting(ctx, meetingID, recordingURL, "")
+}
+
+// requireOrgAgent checks that the agent belongs to the organization, so a
+// meeting never runs an agent from another workspace.
+func (s *meetingService) requireOrgAgent(ctx context.Context, orgID uuid.UUID, agentID uuid.UUID, userID string) error {
+	agent, err := s.queries.GetAgent(ctx, repo.GetAgentParams{
+		AgentID: agentID,
+		UserID:  userID,
+	})
+	if err != nil || agent.OrgID != orgID {
+		return fmt.Errorf("agent not found in organization")
+	}
+	return nil
+}
+
 func toMeetingAgentResponse(meeting repo.GetMeetingRow) *dto.MeetingResponse {
 	return &dto.MeetingResponse{
-		ID:            meeting.ID,
-		Name:          meeting.Name,
-		UserID:        meeting.UserID,
-		AgentID:       meeting.AgentID,
-		Status:        meeting.Status,
-		CreatedAt:     meeting.CreatedAt,
-		UpdatedAt:     meeting.UpdatedAt,
-		StartTime:     meeting.StartTime,
-		EndTime:       meeting.EndTime,
-		TranscriptUrl: meeting.TranscriptUrl,
-		RecordingUrl:  meeting.RecordingUrl,
-		Summary:       meeting.Summary,
+		ID:             meeting.ID,
+		Name:           meeting.Name,
+		UserID:         meeting.UserID,
+		OrgID:          meeting.OrgID,
+		AgentID:        meeting.AgentID,
+		Status:         meeting.Status,
+		CreatedAt:      meeting.CreatedAt,
+		UpdatedAt:      meeting.UpdatedAt,
+		StartTime:      meeting.StartTime,
+		EndTime:        meeting.EndTime,
+		TranscriptUrl:  meeting.TranscriptUrl,
+		RecordingUrl:   meeting.RecordingUrl,
+		Summary:        meeting.Summary,
+		AgentVersionID: meeting.AgentVersionID,
 		AgentDetails: &dto.AgentDetails{
 			Name:         meeting.AgentName,
 			Instructions: meeting.AgentInstructions,
 		},
+		Processing: toProcessingStatus(meeting.ProcessingStatus, meeting.ProcessingStep, meeting.ProcessingError, meeting.ProcessingAttempts),
 	}
 }
 
+func toProcessingStatus(status *string, step *string, errMessage *string, attempts *int32) *dto.ProcessingStatus {
+	if status == nil {
+		return nil
+	}
+	processing := &dto.ProcessingStatus{
+		Status: *status,
+		Step:   step,
+		Error:  errMessage,
+	}
+	if attempts != nil {
+		processing.Attempts = *attempts
+	}
+	return processing
+}
+
 func toMeetingResponse(meeting repo.Meeting) *dto.MeetingResponse {
 	return &dto.MeetingResponse{
-		ID:            meeting.ID,
-		Name:          meeting.Name,
-		UserID:        meeting.UserID,
-		AgentID:       meeting.AgentID,
-		Status:        meeting.Status,
-		TranscriptUrl: meeting.TranscriptUrl,
-		RecordingUrl:  meeting.RecordingUrl,
-		Summary:       meeting.Summary,
-		CreatedAt:     meeting.CreatedAt,
-		UpdatedAt:     meeting.UpdatedAt,
+		ID:             meeting.ID,
+		Name:           meeting.Name,
+		UserID:         meeting.UserID,
+		OrgID:          meeting.OrgID,
+		AgentID:        meeting.AgentID,
+		Status:         meeting.Status,
+		TranscriptUrl:  meeting.TranscriptUrl,
+		RecordingUrl:   meeting.RecordingUrl,
+		Summary:        meeting.Summary,
+		CreatedAt:      meeting.CreatedAt,
+		UpdatedAt:      meeting.UpdatedAt,
+		AgentVersionID: meeting.AgentVersionID,
 	}
 }
 
 func (s *meetingService) onMeetingEnd(meetingID string, recordingURL string, transcriptURL string, err error) {
+	s.sessions.Remove(meetingID)
 	if err != nil {
+		// Still close the meeting out so whatever was captured gets processed.
 		fmt.Printf("[ERROR] Meeting ended with errors: %v\n", err)
-		return
 	}
 	fmt.Println("[-] Meeting ended, starting post-processing", "meetingID", meetingID, "recordingURL", recordingURL,
 		"transcriptURL", transcriptURL)
@@ -369,38 +1114,64 @@ func (s *meetingService) onMeetingEnd(meetingID string, recordingURL string, tra
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	meeting, err := s.queries.GetMeetingByID(ctx, meetingUUID)
-	if err != nil {
-		fmt.Printf("[ERROR] Failed to get meeting for cleanup: %v\n", err)
+	if err := s.completeMeeting(ctx, meetingUUID, recordingURL, transcriptURL); err != nil {
+		fmt.Printf("[ERROR] Failed to update meeting on end: %v\n", err)
 		return
 	}
+	fmt.Println("[-] Meeting cleanup completed successfully", "meetingID", meetingID)
+}
 
+// completeMeeting closes a meeting out, announces it and queues
+// post-processing when anything was captured. It is shared by meetings that
+// end normally and orphaned meetings reconciled after a restart. Completion
+// runs unscoped: the meeting must close out and be processed even if its
+// owner has since left the organization.
+func (s *meetingService) completeMeeting(ctx context.Context, meetingID uuid.UUID, recordingURL string, transcriptURL string) error {
 	endTime := time.Now()
-	updateRequest := dto.UpdateMeetingRequest{
-		ID:      meetingUUID,
-		UserID:  meeting.UserID,
-		Status:  "completed",
+	params := repo.CompleteMeetingParams{
+		ID:      meetingID,
 		EndTime: &endTime,
 	}
-
 	if recordingURL != "" {
-		updateRequest.RecordingURL = &recordingURL
+		params.RecordingUrl = &recordingURL
 	}
 	if transcriptURL != "" {
-		updateRequest.TranscriptURL = &transcriptURL
+		params.TranscriptUrl = &transcriptURL
 	}
 
-	_, err = s.UpdateMeeting(ctx, updateRequest)
+	meeting, err := s.queries.CompleteMeeting(ctx, params)
 	if err != nil {
-		fmt.Printf("[ERROR] Failed to update meeting on end: %v\n", err)
-		return
+		return err
 	}
+	s.publishMeetingEvent(ctx, webhook.EventMeetingCompleted, toMeetingResponse(meeting))
 
-	fmt.Println("[-] Meeting cleanup completed successfully", "meetingID", meetingID)
-
-	if err := s.inngest.PostProcessMeeting(ctx, meetingID, meeting.UserID); err != nil {
+	// Without a recording or transcript there is nothing to process. A
+	// meeting with only a recording is still queued so its processing status
+	// records why no summary was produced.
+	if meeting.RecordingUrl == nil && meeting.TranscriptUrl == nil {
+		return nil
+	}
+	if err := s.inngest.PostProcessMeeting(ctx, meetingID.String()); err != nil {
 		fmt.Printf("[ERROR] Failed to trigger post-processing: %v\n", err)
 	}
+	return nil
+}
+
+// publishMeetingEvent notifies the organization's webhooks of a meeting
+// lifecycle change. Failures are logged and never fail the meeting itself.
+func (s *meetingService) publishMeetingEvent(ctx context.Context, event string, meeting *dto.MeetingResponse) {
+	err := s.inngest.PublishWebhookEvent(ctx, meeting.OrgID, event, webhook.MeetingData{
+		MeetingID: meeting.ID,
+		OrgID:     meeting.OrgID,
+		AgentID:   meeting.AgentID,
+		Name:      meeting.Name,
+		Status:    meeting.Status,
+		StartTime: meeting.StartTime,
+		EndTime:   meeting.EndTime,
+	})
+	if err != nil {
+		fmt.Printf("[ERROR] Failed to publish %s webhook for meeting %s: %v\n", event, meeting.ID, err)
+	}
 }
 
 func parseS3URL(s3URL string) (bucket, key string, err error) {
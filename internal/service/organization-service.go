@@ -0,0 +1,345 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+	"github.com/rahulSailesh-shah/converSense/internal/dto"
+	"github.com/rahulSailesh-shah/converSense/pkg/authz"
+)
+
+type OrganizationService interface {
+	CreateOrganization(ctx context.Context, request dto.CreateOrganizationRequest) (*dto.OrganizationResponse, error)
+	GetOrganizations(ctx context.Context, userID string) ([]dto.OrganizationResponse, error)
+	UpdateOrganization(ctx context.Context, request dto.UpdateOrganizationRequest) (*dto.OrganizationResponse, error)
+	DeleteOrganization(ctx context.Context, request dto.DeleteOrganizationRequest) error
+	// ResolveOrganization returns the organization a request acts in. A nil
+	// orgID selects the user's first organization, creating a personal one
+	// for users who have none.
+	ResolveOrganization(ctx context.Context, userID string, orgID uuid.UUID) (*dto.OrganizationResponse, error)
+	GetMembers(ctx context.Context, request dto.GetOrganizationMembersRequest) ([]dto.OrganizationMemberResponse, error)
+	AddMember(ctx context.Context, request dto.AddOrganizationMemberRequest) (*dto.OrganizationMemberResponse, error)
+	UpdateMemberRole(ctx context.Context, request dto.UpdateOrganizationMemberRequest) (*dto.OrganizationMemberResponse, error)
+	RemoveMember(ctx context.Context, request dto.RemoveOrganizationMemberRequest) error
+}
+
+type organizationService struct {
+	queries *repo.Queries
+	db      *pgxpool.Pool
+}
+
+func NewOrganizationService(db *pgxpool.Pool, queries *repo.Queries) OrganizationService {
+	return &organizationService{
+		db:      db,
+		queries: queries,
+	}
+}
+
+func (s *organizationService) CreateOrganization(ctx context.Context, request dto.CreateOrganizationRequest) (*dto.OrganizationResponse, error) {
+	return s.createOrganization(ctx, request.Name, request.UserID)
+}
+
+func (s *organizationService) GetOrganizations(ctx context.Context, userID string) ([]dto.OrganizationResponse, error) {
+	rows, err := s.queries.GetUserOrganizations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	organizations := make([]dto.OrganizationResponse, 0, len(rows))
+	for _, row := range rows {
+		organizations = append(organizations, toOrganizationResponse(row))
+	}
+	return organizations, nil
+}
+
+func (s *organizationService) UpdateOrganization(ctx context.Context, request dto.UpdateOrganizationRequest) (*dto.OrganizationResponse, error) {
+	member, err := s.getMember(ctx, request.ID, request.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorize(request.UserID, request.ID, member.Role, authz.ResourceOrganization, authz.ActionUpdate); err != nil {
+		return nil, err
+	}
+
+	organization, err := s.queries.UpdateOrganization(ctx, repo.UpdateOrganizationParams{
+		ID:   request.ID,
+		Name: request.Name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &dto.OrganizationResponse{
+		ID:        organization.ID,
+		Name:      organization.Name,
+		CreatedBy: organization.CreatedBy,
+		Role:      member.Role,
+		CreatedAt: organization.CreatedAt,
+		UpdatedAt: organization.UpdatedAt,
+	}, nil
+}
+
+// DeleteOrganization deletes the organization together with its agents and
+// meetings.
+func (s *organizationService) DeleteOrganization(ctx context.Context, request dto.DeleteOrganizationRequest) error {
+	member, err := s.getMember(ctx, request.ID, request.UserID)
+	if err != nil {
+		return err
+	}
+	if err := authorize(request.UserID, request.ID, member.Role, authz.ResourceOrganization, authz.ActionDelete); err != nil {
+		return err
+	}
+	return s.queries.DeleteOrganization(ctx, request.ID)
+}
+
+func (s *organizationService) ResolveOrganization(ctx context.Context, userID string, orgID uuid.UUID) (*dto.OrganizationResponse, error) {
+	if orgID != uuid.Nil {
+		member, err := s.getMember(ctx, orgID, userID)
+		if err != nil {
+			return nil, err
+		}
+		organization, err := s.queries.GetOrganization(ctx, orgID)
+		if err != nil {
+			return nil, err
+		}
+		return &dto.OrganizationResponse{
+			ID:        organization.ID,
+			Name:      organization.Name,
+			CreatedBy: organization.CreatedBy,
+			Role:      member.Role,
+			CreatedAt: organization.CreatedAt,
+			UpdatedAt: organization.UpdatedAt,
+		}, nil
+	}
+
+	rows, err := s.queries.GetUserOrganizations(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) > 0 {
+		organization := toOrganizationResponse(rows[0])
+		return &organization, nil
+	}
+
+	name := "Personal workspace"
+	if user, err := s.queries.GetUserByID(ctx, userID); err == nil && user.Name != "" {
+		name = user.Name + "'s workspace"
+	}
+	return s.createOrganization(ctx, name, userID)
+}
+
+func (s *organizationService) GetMembers(ctx context.Context, request dto.GetOrganizationMembersRequest) ([]dto.OrganizationMemberResponse, error) {
+	if err := authorizeInOrg(ctx, s.queries, request.UserID, request.OrgID, authz.ResourceMember, authz.ActionRead); err != nil {
+		return nil, err
+	}
+
+	rows, err := s.queries.GetOrganizationMembers(ctx, request.OrgID)
+	if err != nil {
+		return nil, err
+	}
+	members := make([]dto.OrganizationMemberResponse, 0, len(rows))
+	for _, row := range rows {
+		members = append(members, dto.OrganizationMemberResponse{
+			OrgID:     row.OrgID,
+			UserID:    row.UserID,
+			Name:      row.UserName,
+			Email:     row.UserEmail,
+			Role:      row.Role,
+			CreatedAt: row.CreatedAt,
+			UpdatedAt: row.UpdatedAt,
+		})
+	}
+	return members, nil
+}
+
+func (s *organizationService) AddMember(ctx context.Context, request dto.AddOrganizationMemberRequest) (*dto.OrganizationMemberResponse, error) {
+	member, err := s.getMember(ctx, request.OrgID, request.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorize(request.UserID, request.OrgID, member.Role, authz.ResourceMember, authz.ActionCreate); err != nil {
+		return nil, err
+	}
+	if err := checkOwnerAssignment(member.Role, request.Role); err != nil {
+		return nil, err
+	}
+
+	var user repo.User
+	if request.MemberID != "" {
+		user, err = s.queries.GetUserByID(ctx, request.MemberID)
+	} else {
+		user, err = s.queries.GetUserByEmail(ctx, request.Email)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("user not found")
+	}
+
+	newMember, err := s.queries.CreateOrganizationMember(ctx, repo.CreateOrganizationMemberParams{
+		OrgID:  request.OrgID,
+		UserID: user.ID,
+		Role:   request.Role,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to add member: %w", err)
+	}
+	return toOrganizationMemberResponse(newMember, user), nil
+}
+
+func (s *organizationService) UpdateMemberRole(ctx context.Context, request dto.UpdateOrganizationMemberRequest) (*dto.OrganizationMemberResponse, error) {
+	member, err := s.getMember(ctx, request.OrgID, request.UserID)
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.getMember(ctx, request.OrgID, request.MemberID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorize(request.UserID, request.OrgID, member.Role, authz.ResourceMember, authz.ActionUpdate); err != nil {
+		return nil, err
+	}
+	if err := checkOwnerAssignment(member.Role, target.Role); err != nil {
+		return nil, err
+	}
+	if err := checkOwnerAssignment(member.Role, request.Role); err != nil {
+		return nil, err
+	}
+	if target.Role == authz.RoleOwner && request.Role != authz.RoleOwner {
+		if err := s.requireAnotherOwner(ctx, request.OrgID); err != nil {
+			return nil, err
+		}
+	}
+
+	updatedMember, err := s.queries.UpdateOrganizationMemberRole(ctx, repo.UpdateOrganizationMemberRoleParams{
+		OrgID:  request.OrgID,
+		UserID: request.MemberID,
+		Role:   request.Role,
+	})
+	if err != nil {
+		return nil, err
+	}
+	user, _ := s.queries.GetUserByID(ctx, request.MemberID)
+	return toOrganizationMemberResponse(updatedMember, user), nil
+}
+
+// RemoveMember removes a member from the organization. Any member may leave
+// on their own; removing someone else takes an owner or admin.
+func (s *organizationService) RemoveMember(ctx context.Context, request dto.RemoveOrganizationMemberRequest) error {
+	target, err := s.getMember(ctx, request.OrgID, request.MemberID)
+	if err != nil {
+		return err
+	}
+	if request.MemberID != request.UserID {
+		member, err := s.getMember(ctx, request.OrgID, request.UserID)
+		if err != nil {
+			return err
+		}
+		if err := authorize(request.UserID, request.OrgID, member.Role, authz.ResourceMember, authz.ActionDelete); err != nil {
+			return err
+		}
+		if err := checkOwnerAssignment(member.Role, target.Role); err != nil {
+			return err
+		}
+	}
+	if target.Role == authz.RoleOwner {
+		if err := s.requireAnotherOwner(ctx, request.OrgID); err != nil {
+			return err
+		}
+	}
+
+	return s.queries.DeleteOrganizationMember(ctx, repo.DeleteOrganizationMemberParams{
+		OrgID:  request.OrgID,
+		UserID: request.MemberID,
+	})
+}
+
+func (s *organizationService) createOrganization(ctx context.Context, name string, userID string) (*dto.OrganizationResponse, error) {
+	tx, err := s.db.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := s.queries.WithTx(tx)
+
+	organization, err := qtx.CreateOrganization(ctx, repo.CreateOrganizationParams{
+		Name:      name,
+		CreatedBy: userID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if _, err := qtx.CreateOrganizationMember(ctx, repo.CreateOrganizationMemberParams{
+		OrgID:  organization.ID,
+		UserID: userID,
+		Role:   authz.RoleOwner,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+	return &dto.OrganizationResponse{
+		ID:        organization.ID,
+		Name:      organization.Name,
+		CreatedBy: organization.CreatedBy,
+		Role:      authz.RoleOwner,
+		CreatedAt: organization.CreatedAt,
+		UpdatedAt: organization.UpdatedAt,
+	}, nil
+}
+
+func (s *organizationService) getMember(ctx context.Context, orgID uuid.UUID, userID string) (repo.OrganizationMember, error) {
+	member, err := s.queries.GetOrganizationMember(ctx, repo.GetOrganizationMemberParams{
+		OrgID:  orgID,
+		UserID: userID,
+	})
+	if err != nil {
+		return repo.OrganizationMember{}, fmt.Errorf("%w: not a member of this organization", authz.ErrForbidden)
+	}
+	return member, nil
+}
+
+// requireAnotherOwner keeps an organization from losing its last owner.
+func (s *organizationService) requireAnotherOwner(ctx context.Context, orgID uuid.UUID) error {
+	owners, err := s.queries.CountOrganizationOwners(ctx, orgID)
+	if err != nil {
+		return err
+	}
+	if owners <= 1 {
+		return fmt.Errorf("an organization must keep at least one owner")
+	}
+	return nil
+}
+
+// checkOwnerAssignment keeps admins from granting, changing or revoking the
+// owner role. Only owners manage other owners.
+func checkOwnerAssignment(role string, target string) error {
+	if target == authz.RoleOwner && role != authz.RoleOwner {
+		return fmt.Errorf("%w: only owners can manage owners", authz.ErrForbidden)
+	}
+	return nil
+}
+
+func toOrganizationResponse(row repo.GetUserOrganizationsRow) dto.OrganizationResponse {
+	return dto.OrganizationResponse{
+		ID:        row.ID,
+		Name:      row.Name,
+		CreatedBy: row.CreatedBy,
+		Role:      row.Role,
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
+
+func toOrganizationMemberResponse(member repo.OrganizationMember, user repo.User) *dto.OrganizationMemberResponse {
+	return &dto.OrganizationMemberResponse{
+		OrgID:     member.OrgID,
+		UserID:    member.UserID,
+		Name:      user.Name,
+		Email:     user.Email,
+		Role:      member.Role,
+		CreatedAt: member.CreatedAt,
+		UpdatedAt: member.UpdatedAt,
+	}
+}
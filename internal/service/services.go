@@ -5,23 +5,41 @@ import (
 	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
 	"github.com/rahulSailesh-shah/converSense/pkg/config"
 	"github.com/rahulSailesh-shah/converSense/pkg/inngest"
+	"github.com/rahulSailesh-shah/converSense/pkg/knowledge"
+	"github.com/rahulSailesh-shah/converSense/pkg/livekit"
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
 )
 
 type Service struct {
-	Agent   AgentService
-	Meeting MeetingService
-	Chat    ChatService
+	Agent        AgentService
+	Meeting      MeetingService
+	Chat         ChatService
+	Organization OrganizationService
+	APIKey       APIKeyService
+	Webhook      WebhookService
+
+	// Live meeting sessions owned by this process
+	Sessions *livekit.SessionRegistry
 }
 
-func NewService(db *pgxpool.Pool, queries *repo.Queries, inngest *inngest.Inngest, cfg *config.AppConfig) *Service {
+func NewService(db *pgxpool.Pool, queries *repo.Queries, inngest *inngest.Inngest, models *llm.Models, cfg *config.AppConfig) *Service {
 	// Initialize Services
-	agentService := NewAgentService(db, queries)
-	meetingService := NewMeetingService(db, queries, inngest, &cfg.LiveKit, &cfg.Gemini, &cfg.AWS)
-	chatService := NewChatService(queries, &cfg.OpenAI, &cfg.AWS)
+	sessions := livekit.NewSessionRegistry()
+	knowledgeBase := knowledge.NewBase(db, queries, models.Document, models.Embedding)
+	agentService := NewAgentService(db, queries, knowledgeBase)
+	meetingService := NewMeetingService(db, queries, inngest, sessions, knowledgeBase, &cfg.LiveKit, &cfg.Gemini, &cfg.AWS, &cfg.Realtime, &cfg.LLM, models.Sentiment, models.RollingSummary)
+	chatService := NewChatService(queries, knowledgeBase, models.Chat, &cfg.AWS)
+	organizationService := NewOrganizationService(db, queries)
+	apiKeyService := NewAPIKeyService(queries)
+	webhookService := NewWebhookService(queries, inngest)
 
 	return &Service{
-		Agent:   agentService,
-		Meeting: meetingService,
-		Chat:    chatService,
+		Agent:        agentService,
+		Meeting:      meetingService,
+		Chat:         chatService,
+		Organization: organizationService,
+		APIKey:       apiKeyService,
+		Webhook:      webhookService,
+		Sessions:     sessions,
 	}
 }
@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+	"github.com/rahulSailesh-shah/converSense/internal/dto"
+	"github.com/rahulSailesh-shah/converSense/pkg/authz"
+	"github.com/rahulSailesh-shah/converSense/pkg/inngest"
+	"github.com/rahulSailesh-shah/converSense/pkg/safehttp"
+	"github.com/rahulSailesh-shah/converSense/pkg/webhook"
+)
+
+type WebhookService interface {
+	CreateEndpoint(ctx context.Context, request dto.CreateWebhookEndpointRequest) (*dto.CreatedWebhookEndpointResponse, error)
+	GetEndpoints(ctx context.Context, userID string, orgID uuid.UUID) ([]dto.WebhookEndpointResponse, error)
+	UpdateEndpoint(ctx context.Context, request dto.UpdateWebhookEndpointRequest) (*dto.WebhookEndpointResponse, error)
+	DeleteEndpoint(ctx context.Context, request dto.DeleteWebhookEndpointRequest) error
+	GetDeliveries(ctx context.Context, request dto.GetWebhookDeliveriesRequest) ([]dto.WebhookDeliveryResponse, error)
+	// ReplayDelivery sends a past delivery's payload again as a new delivery.
+	ReplayDelivery(ctx context.Context, request dto.ReplayWebhookDeliveryRequest) (*dto.WebhookDeliveryResponse, error)
+}
+
+type webhookService struct {
+	queries *repo.Queries
+	inngest *inngest.Inngest
+}
+
+func NewWebhookService(queries *repo.Queries, inngest *inngest.Inngest) WebhookService {
+	return &webhookService{
+		queries: queries,
+		inngest: inngest,
+	}
+}
+
+func (s *webhookService) CreateEndpoint(ctx context.Context, request dto.CreateWebhookEndpointRequest) (*dto.CreatedWebhookEndpointResponse, error) {
+	if err := authorizeInOrg(ctx, s.queries, request.UserID, request.OrgID, authz.ResourceWebhook, authz.ActionCreate); err != nil {
+		return nil, err
+	}
+	if err := safehttp.ValidateURL(request.URL); err != nil {
+		return nil, err
+	}
+	if err := webhook.ValidateEvents(request.Events); err != nil {
+		return nil, err
+	}
+	events := request.Events
+	if events == nil {
+		events = []string{}
+	}
+
+	secret := request.Secret
+	if secret == "" {
+		var err error
+		secret, err = webhook.GenerateSecret()
+		if err != nil {
+			return nil, err
+		}
+	}
+	endpoint, err := s.queries.CreateWebhookEndpoint(ctx, repo.CreateWebhookEndpointParams{
+		OrgID:     request.OrgID,
+		Url:       request.URL,
+		Secret:    secret,
+		Events:    events,
+		CreatedBy: request.UserID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &dto.CreatedWebhookEndpointResponse{
+		WebhookEndpointResponse: toWebhookEndpointResponse(endpoint),
+		Secret:                  endpoint.Secret,
+	}, nil
+}
+
+func (s *webhookService) GetEndpoints(ctx context.Context, userID string, orgID uuid.UUID) ([]dto.WebhookEndpointResponse, error) {
+	if err := authorizeInOrg(ctx, s.queries, userID, orgID, authz.ResourceWebhook, authz.ActionRead); err != nil {
+		return nil, err
+	}
+	rows, err := s.queries.GetWebhookEndpoints(ctx, orgID)
+	if err != nil {
+		return nil, err
+	}
+	endpoints := make([]dto.WebhookEndpointResponse, 0, len(rows))
+	for _, row := range rows {
+		endpoints = append(endpoints, toWebhookEndpointResponse(row))
+	}
+	return endpoints, nil
+}
+
+func (s *webhookService) UpdateEndpoint(ctx context.Context, request dto.UpdateWebhookEndpointRequest) (*dto.WebhookEndpointResponse, error) {
+	endpoint, err := s.getEndpoint(ctx, request.ID, request.UserID, authz.ActionUpdate)
+	if err != nil {
+		return nil, err
+	}
+	if err := safehttp.ValidateURL(request.URL); err != nil {
+		return nil, err
+	}
+	if err := webhook.ValidateEvents(request.Events); err != nil {
+		return nil, err
+	}
+	events := request.Events
+	if events == nil {
+		events = []string{}
+	}
+	active := endpoint.Active
+	if request.Active != nil {
+		active = *request.Active
+	}
+
+	updated, err := s.queries.UpdateWebhookEndpoint(ctx, repo.UpdateWebhookEndpointParams{
+		ID:     endpoint.ID,
+		Url:    request.URL,
+		Events: events,
+		Active: active,
+	})
+	if err != nil {
+		return nil, err
+	}
+	response := toWebhookEndpointResponse(updated)
+	return &response, nil
+}
+
+func (s *webhookService) DeleteEndpoint(ctx context.Context, request dto.DeleteWebhookEndpointRequest) error {
+	endpoint, err := s.getEndpoint(ctx, request.ID, request.UserID, authz.ActionDelete)
+	if err != nil {
+		return err
+	}
+	return s.queries.DeleteWebhookEndpoint(ctx, endpoint.ID)
+}
+
+func (s *webhookService) GetDeliveries(ctx context.Context, request dto.GetWebhookDeliveriesRequest) ([]dto.WebhookDeliveryResponse, error) {
+	endpoint, err := s.getEndpoint(ctx, request.EndpointID, request.UserID, authz.ActionRead)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.queries.GetWebhookDeliveries(ctx, repo.GetWebhookDeliveriesParams{
+		EndpointID: endpoint.ID,
+		Limit:      request.Limit,
+		Offset:     request.Offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+	deliveries := make([]dto.WebhookDeliveryResponse, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, toWebhookDeliveryResponse(row))
+	}
+	return deliveries, nil
+}
+
+func (s *webhookService) ReplayDelivery(ctx context.Context, request dto.ReplayWebhookDeliveryRequest) (*dto.WebhookDeliveryResponse, error) {
+	endpoint, err := s.getEndpoint(ctx, request.EndpointID, request.UserID, authz.ActionUpdate)
+	if err != nil {
+		return nil, err
+	}
+	original, err := s.queries.GetWebhookDelivery(ctx, request.DeliveryID)
+	if err != nil || original.EndpointID != endpoint.ID {
+		return nil, fmt.Errorf("webhook delivery not found")
+	}
+
+	delivery, err := s.queries.CreateWebhookDelivery(ctx, repo.CreateWebhookDeliveryParams{
+		EndpointID: endpoint.ID,
+		Event:      original.Event,
+		Payload:    original.Payload,
+		ReplayOf:   &original.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := s.inngest.DeliverWebhook(ctx, delivery.ID); err != nil {
+		return nil, fmt.Errorf("failed to queue webhook delivery: %w", err)
+	}
+	response := toWebhookDeliveryResponse(delivery)
+	return &response, nil
+}
+
+// getEndpoint loads an endpoint and authorizes the action against the
+// user's role in the organization owning it.
+func (s *webhookService) getEndpoint(ctx context.Context, id uuid.UUID, userID string, action authz.Action) (*repo.WebhookEndpoint, error) {
+	endpoint, err := s.queries.GetWebhookEndpoint(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("webhook not found")
+	}
+	if err := authorizeInOrg(ctx, s.queries, userID, endpoint.OrgID, authz.ResourceWebhook, action); err != nil {
+		return nil, err
+	}
+	return &endpoint, nil
+}
+
+func toWebhookEndpointResponse(endpoint repo.WebhookEndpoint) dto.WebhookEndpointResponse {
+	return dto.WebhookEndpointResponse{
+		ID:        endpoint.ID,
+		OrgID:     endpoint.OrgID,
+		URL:       endpoint.Url,
+		Events:    endpoint.Events,
+		Active:    endpoint.Active,
+		CreatedBy: endpoint.CreatedBy,
+		CreatedAt: endpoint.CreatedAt,
+		UpdatedAt: endpoint.UpdatedAt,
+	}
+}
+
+func toWebhookDeliveryResponse(delivery repo.WebhookDelivery) dto.WebhookDeliveryResponse {
+	return dto.WebhookDeliveryResponse{
+		ID:             delivery.ID,
+		EndpointID:     delivery.EndpointID,
+		Event:          delivery.Event,
+		Payload:        delivery.Payload,
+		Status:         delivery.Status,
+		Attempts:       delivery.Attempts,
+		ResponseStatus: delivery.ResponseStatus,
+		LastError:      delivery.LastError,
+		ReplayOf:       delivery.ReplayOf,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+	}
+}
@@ -1,13 +1,18 @@
 package handler
 
 import (
+	"fmt"
+	"io"
 	"net/http"
+	"path/filepath"
 	"strconv"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/rahulSailesh-shah/converSense/internal/dto"
 	"github.com/rahulSailesh-shah/converSense/internal/service"
+	"github.com/rahulSailesh-shah/converSense/pkg/agentconfig"
+	"github.com/rahulSailesh-shah/converSense/pkg/knowledge"
 )
 
 type AgentHandler struct {
@@ -26,10 +31,25 @@ func (h *AgentHandler) CreateAgent(c *gin.Context) {
 		c.JSON(400, gin.H{"error": err.Error()})
 		return
 	}
+	if err := agentconfig.ValidateTools(req.Tools); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid agent tools",
+			Error:   err.Error(),
+		})
+		return
+	}
+	if err := agentconfig.ValidateSettings(req.Settings); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid agent settings",
+			Error:   err.Error(),
+		})
+		return
+	}
 	req.UserID = c.MustGet("userId").(string)
+	req.OrgID = c.MustGet("orgId").(uuid.UUID)
 	agent, err := h.agentService.CreateAgent(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		c.JSON(errorStatus(err), dto.ErrorResponse{
 			Message: "Failed to create agent",
 			Error:   err.Error(),
 		})
@@ -48,6 +68,22 @@ func (h *AgentHandler) UpdateAgent(c *gin.Context) {
 		c.JSON(400, gin.H{"error": err.Error()})
 		return
 	}
+	if err := agentconfig.ValidateTools(req.Tools); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid agent tools",
+			Error:   err.Error(),
+		})
+		return
+	}
+	if req.Settings != nil {
+		if err := agentconfig.ValidateSettings(*req.Settings); err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Message: "Invalid agent settings",
+				Error:   err.Error(),
+			})
+			return
+		}
+	}
 	var err error
 	req.ID, err = uuid.Parse(c.Param("id"))
 	if err != nil {
@@ -60,7 +96,7 @@ func (h *AgentHandler) UpdateAgent(c *gin.Context) {
 	req.UserID = c.MustGet("userId").(string)
 	agent, err := h.agentService.UpdateAgent(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		c.JSON(errorStatus(err), dto.ErrorResponse{
 			Message: "Failed to update agent",
 			Error:   err.Error(),
 		})
@@ -83,9 +119,10 @@ func (h *AgentHandler) GetAgents(c *gin.Context) {
 		Limit:  int32(limit),
 		Offset: int32((page - 1) * limit),
 		UserID: c.MustGet("userId").(string),
+		OrgID:  c.MustGet("orgId").(uuid.UUID),
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		c.JSON(errorStatus(err), dto.ErrorResponse{
 			Message: "Failed to get agents",
 			Error:   err.Error(),
 		})
@@ -113,7 +150,7 @@ func (h *AgentHandler) GetAgent(c *gin.Context) {
 		UserID: c.MustGet("userId").(string),
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		c.JSON(errorStatus(err), dto.ErrorResponse{
 			Message: "Failed to get agent",
 			Error:   err.Error(),
 		})
@@ -141,7 +178,7 @@ func (h *AgentHandler) DeleteAgent(c *gin.Context) {
 		UserID: c.MustGet("userId").(string),
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		c.JSON(errorStatus(err), dto.ErrorResponse{
 			Message: "Failed to delete agent",
 			Error:   err.Error(),
 		})
@@ -152,3 +189,287 @@ func (h *AgentHandler) DeleteAgent(c *gin.Context) {
 		Message: "Agent deleted successfully",
 	})
 }
+
+func (h *AgentHandler) UploadAgentDocument(c *gin.Context) {
+	agentId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid agent ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "A document file is required",
+			Error:   err.Error(),
+		})
+		return
+	}
+	if fileHeader.Size > knowledge.MaxDocumentBytes {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Document is too large",
+			Error:   fmt.Sprintf("documents can be at most %d bytes", knowledge.MaxDocumentBytes),
+		})
+		return
+	}
+	if _, err := knowledge.ContentTypeFor(fileHeader.Filename); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Unsupported document type",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Failed to read document",
+			Error:   err.Error(),
+		})
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, knowledge.MaxDocumentBytes))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Failed to read document",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	document, err := h.agentService.UploadAgentDocument(c.Request.Context(), dto.UploadAgentDocumentRequest{
+		AgentID:  agentId,
+		UserID:   c.MustGet("userId").(string),
+		FileName: filepath.Base(fileHeader.Filename),
+		Data:     data,
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to upload document",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Document uploaded successfully",
+		Data:    document,
+	})
+}
+
+func (h *AgentHandler) GetAgentDocuments(c *gin.Context) {
+	agentId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid agent ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	documents, err := h.agentService.GetAgentDocuments(c.Request.Context(), dto.GetAgentDocumentsRequest{
+		AgentID: agentId,
+		UserID:  c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to get documents",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Documents retrieved successfully",
+		Data:    documents,
+	})
+}
+
+func (h *AgentHandler) DeleteAgentDocument(c *gin.Context) {
+	agentId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid agent ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+	documentId, err := uuid.Parse(c.Param("documentId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid document ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	err = h.agentService.DeleteAgentDocument(c.Request.Context(), dto.DeleteAgentDocumentRequest{
+		ID:      documentId,
+		AgentID: agentId,
+		UserID:  c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to delete document",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Document deleted successfully",
+	})
+}
+
+func (h *AgentHandler) GetAgentVersions(c *gin.Context) {
+	agentId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid agent ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	versions, err := h.agentService.GetAgentVersions(c.Request.Context(), dto.GetAgentVersionsRequest{
+		AgentID: agentId,
+		UserID:  c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to get agent versions",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Agent versions retrieved successfully",
+		Data:    versions,
+	})
+}
+
+func (h *AgentHandler) DiffAgentVersions(c *gin.Context) {
+	var req dto.DiffAgentVersionsRequest
+	if err := c.ShouldBindQuery(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Both from and to versions are required",
+			Error:   err.Error(),
+		})
+		return
+	}
+	var err error
+	req.AgentID, err = uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid agent ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+	req.UserID = c.MustGet("userId").(string)
+
+	diff, err := h.agentService.DiffAgentVersions(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to diff agent versions",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Agent versions compared successfully",
+		Data:    diff,
+	})
+}
+
+func (h *AgentHandler) RollbackAgent(c *gin.Context) {
+	agentId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid agent ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil || version < 1 {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid agent version",
+			Error:   fmt.Sprintf("version must be a positive integer, got %q", c.Param("version")),
+		})
+		return
+	}
+
+	agent, err := h.agentService.RollbackAgent(c.Request.Context(), dto.RollbackAgentRequest{
+		AgentID: agentId,
+		UserID:  c.MustGet("userId").(string),
+		Version: int32(version),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to roll back agent",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Agent rolled back successfully",
+		Data:    agent,
+	})
+}
+
+func (h *AgentHandler) CloneAgent(c *gin.Context) {
+	var req dto.CloneAgentRequest
+	// The body is optional; an empty one clones with the default name.
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			c.JSON(400, gin.H{"error": err.Error()})
+			return
+		}
+	}
+	var err error
+	req.ID, err = uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid agent ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+	req.UserID = c.MustGet("userId").(string)
+
+	agent, err := h.agentService.CloneAgent(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to clone agent",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Agent cloned successfully",
+		Data:    agent,
+	})
+}
+
+func (h *AgentHandler) GetAgentTemplates(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Agent templates retrieved successfully",
+		Data:    h.agentService.GetAgentTemplates(c.Request.Context()),
+	})
+}
+
+func (h *AgentHandler) GetSummaryTemplates(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Summary templates retrieved successfully",
+		Data:    h.agentService.GetSummaryTemplates(c.Request.Context()),
+	})
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rahulSailesh-shah/converSense/internal/dto"
+	"github.com/rahulSailesh-shah/converSense/internal/service"
+)
+
+type APIKeyHandler struct {
+	apiKeyService service.APIKeyService
+}
+
+func NewAPIKeyHandler(apiKeyService service.APIKeyService) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyService: apiKeyService,
+	}
+}
+
+func (h *APIKeyHandler) CreateAPIKey(c *gin.Context) {
+	var req dto.CreateAPIKeyRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req.UserID = c.MustGet("userId").(string)
+	apiKey, err := h.apiKeyService.CreateAPIKey(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Failed to create API key",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "API key created successfully",
+		Data:    apiKey,
+	})
+}
+
+func (h *APIKeyHandler) GetAPIKeys(c *gin.Context) {
+	apiKeys, err := h.apiKeyService.GetAPIKeys(c.Request.Context(), c.MustGet("userId").(string))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to get API keys",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "API keys retrieved successfully",
+		Data:    apiKeys,
+	})
+}
+
+func (h *APIKeyHandler) RevokeAPIKey(c *gin.Context) {
+	keyId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid API key ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	err = h.apiKeyService.RevokeAPIKey(c.Request.Context(), dto.RevokeAPIKeyRequest{
+		ID:     keyId,
+		UserID: c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to revoke API key",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "API key revoked successfully",
+	})
+}
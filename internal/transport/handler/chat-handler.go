@@ -50,7 +50,7 @@ func (h *ChatHandler) Chat(c *gin.Context) {
 	if err != nil {
 		// Distinguish between auth error and other errors if possible,
 		// but for now 500 or 403 based on error string is a simple heuristic
-		status := http.StatusInternalServerError
+		status := errorStatus(err)
 		if err.Error() == "unauthorized: user does not have access to this meeting or meeting does not exist" {
 			status = http.StatusForbidden
 		}
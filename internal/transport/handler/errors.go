@@ -0,0 +1,21 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/rahulSailesh-shah/converSense/pkg/authz"
+	"github.com/rahulSailesh-shah/converSense/pkg/inngest"
+)
+
+// errorStatus maps a service error to its HTTP status: 403 for
+// authorization failures, 409 for work already in progress, 500 otherwise.
+func errorStatus(err error) int {
+	if errors.Is(err, authz.ErrForbidden) {
+		return http.StatusForbidden
+	}
+	if errors.Is(err, inngest.ErrProcessingInProgress) {
+		return http.StatusConflict
+	}
+	return http.StatusInternalServerError
+}
@@ -27,9 +27,10 @@ func (h *MeetingHandler) CreateMeeting(c *gin.Context) {
 		return
 	}
 	req.UserID = c.MustGet("userId").(string)
+	req.OrgID = c.MustGet("orgId").(uuid.UUID)
 	meeting, err := h.meetingService.CreateMeeting(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		c.JSON(errorStatus(err), dto.ErrorResponse{
 			Message: "Failed to create meeting",
 			Error:   err.Error(),
 		})
@@ -60,7 +61,7 @@ func (h *MeetingHandler) UpdateMeeting(c *gin.Context) {
 	req.UserID = c.MustGet("userId").(string)
 	meeting, err := h.meetingService.UpdateMeeting(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		c.JSON(errorStatus(err), dto.ErrorResponse{
 			Message: "Failed to update meeting",
 			Error:   err.Error(),
 		})
@@ -83,9 +84,10 @@ func (h *MeetingHandler) GetMeetings(c *gin.Context) {
 		Limit:  int32(limit),
 		Offset: int32((page - 1) * limit),
 		UserID: c.MustGet("userId").(string),
+		OrgID:  c.MustGet("orgId").(uuid.UUID),
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		c.JSON(errorStatus(err), dto.ErrorResponse{
 			Message: "Failed to get agents",
 			Error:   err.Error(),
 		})
@@ -113,7 +115,7 @@ func (h *MeetingHandler) GetMeeting(c *gin.Context) {
 		UserID: c.MustGet("userId").(string),
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		c.JSON(errorStatus(err), dto.ErrorResponse{
 			Message: "Failed to get agent",
 			Error:   err.Error(),
 		})
@@ -140,7 +142,7 @@ func (h *MeetingHandler) DeleteMeeting(c *gin.Context) {
 		ID: agentId,
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		c.JSON(errorStatus(err), dto.ErrorResponse{
 			Message: "Failed to delete agent",
 			Error:   err.Error(),
 		})
@@ -167,7 +169,7 @@ func (h *MeetingHandler) StartMeeting(c *gin.Context) {
 		UserID: c.MustGet("userId").(string),
 	})
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		c.JSON(errorStatus(err), dto.ErrorResponse{
 			Message: "Failed to start meeting",
 			Error:   err.Error(),
 		})
@@ -182,6 +184,61 @@ func (h *MeetingHandler) StartMeeting(c *gin.Context) {
 	})
 }
 
+func (h *MeetingHandler) StopMeeting(c *gin.Context) {
+	meetingId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid meeting ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	err = h.meetingService.StopMeeting(c.Request.Context(), dto.StopMeetingRequest{
+		ID:     meetingId,
+		UserID: c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to stop meeting",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Meeting stopped successfully",
+	})
+}
+
+func (h *MeetingHandler) GetLiveSession(c *gin.Context) {
+	meetingId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid meeting ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	live, err := h.meetingService.GetLiveSession(c.Request.Context(), dto.GetMeetingRequest{
+		ID:     meetingId,
+		UserID: c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to get live session",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Live session retrieved successfully",
+		Data:    live,
+	})
+}
+
 func (h *MeetingHandler) GetPreSignedRecordingURL(c *gin.Context) {
 	meetingId, err := uuid.Parse(c.Param("id"))
 	if err != nil {
@@ -202,7 +259,7 @@ func (h *MeetingHandler) GetPreSignedRecordingURL(c *gin.Context) {
 	req.UserID = c.MustGet("userId").(string)
 	url, err := h.meetingService.GetPreSignedRecordingURL(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+		c.JSON(errorStatus(err), dto.ErrorResponse{
 			Message: "Failed to get pre-signed recording URL",
 			Error:   err.Error(),
 		})
@@ -214,3 +271,400 @@ func (h *MeetingHandler) GetPreSignedRecordingURL(c *gin.Context) {
 		Data:    url,
 	})
 }
+
+func (h *MeetingHandler) JoinMeeting(c *gin.Context) {
+	meetingId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid meeting ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	token, err := h.meetingService.JoinMeeting(c.Request.Context(), dto.JoinMeetingRequest{
+		ID:     meetingId,
+		UserID: c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to join meeting",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Meeting joined successfully",
+		Data: map[string]string{
+			"token": token,
+		},
+	})
+}
+
+func (h *MeetingHandler) InviteParticipant(c *gin.Context) {
+	meetingId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid meeting ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	var req dto.InviteParticipantRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	req.MeetingID = meetingId
+	req.UserID = c.MustGet("userId").(string)
+	participant, err := h.meetingService.InviteParticipant(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to invite participant",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Participant invited successfully",
+		Data:    participant,
+	})
+}
+
+func (h *MeetingHandler) GetParticipants(c *gin.Context) {
+	meetingId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid meeting ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	participants, err := h.meetingService.GetParticipants(c.Request.Context(), dto.GetParticipantsRequest{
+		MeetingID: meetingId,
+		UserID:    c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to get participants",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Participants retrieved successfully",
+		Data:    participants,
+	})
+}
+
+func (h *MeetingHandler) GetMeetingNotes(c *gin.Context) {
+	meetingId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid meeting ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	notes, err := h.meetingService.GetMeetingNotes(c.Request.Context(), dto.GetMeetingNotesRequest{
+		MeetingID: meetingId,
+		UserID:    c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to get meeting notes",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Meeting notes retrieved successfully",
+		Data:    notes,
+	})
+}
+
+func (h *MeetingHandler) GetActionItems(c *gin.Context) {
+	meetingId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid meeting ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	items, err := h.meetingService.GetActionItems(c.Request.Context(), dto.GetMeetingItemsRequest{
+		MeetingID: meetingId,
+		UserID:    c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to get action items",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Action items retrieved successfully",
+		Data:    items,
+	})
+}
+
+func (h *MeetingHandler) UpdateActionItem(c *gin.Context) {
+	meetingId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid meeting ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+	itemId, err := uuid.Parse(c.Param("itemId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid action item ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	var req dto.UpdateActionItemRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req.MeetingID = meetingId
+	req.ItemID = itemId
+	req.UserID = c.MustGet("userId").(string)
+
+	item, err := h.meetingService.UpdateActionItem(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to update action item",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Action item updated successfully",
+		Data:    item,
+	})
+}
+
+func (h *MeetingHandler) GetDecisions(c *gin.Context) {
+	meetingId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid meeting ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	decisions, err := h.meetingService.GetDecisions(c.Request.Context(), dto.GetMeetingItemsRequest{
+		MeetingID: meetingId,
+		UserID:    c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to get decisions",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Decisions retrieved successfully",
+		Data:    decisions,
+	})
+}
+
+func (h *MeetingHandler) GetOpenQuestions(c *gin.Context) {
+	meetingId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid meeting ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	questions, err := h.meetingService.GetOpenQuestions(c.Request.Context(), dto.GetMeetingItemsRequest{
+		MeetingID: meetingId,
+		UserID:    c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to get open questions",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Open questions retrieved successfully",
+		Data:    questions,
+	})
+}
+
+func (h *MeetingHandler) ReprocessMeeting(c *gin.Context) {
+	meetingId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid meeting ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	var req dto.ReprocessMeetingRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req.MeetingID = meetingId
+	req.UserID = c.MustGet("userId").(string)
+
+	if err := h.meetingService.ReprocessMeeting(c.Request.Context(), req); err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to reprocess meeting",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Meeting reprocessing queued successfully",
+	})
+}
+
+func (h *MeetingHandler) GetSummaries(c *gin.Context) {
+	meetingId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid meeting ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	summaries, err := h.meetingService.GetSummaries(c.Request.Context(), dto.GetMeetingItemsRequest{
+		MeetingID: meetingId,
+		UserID:    c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to get summaries",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Summaries retrieved successfully",
+		Data:    summaries,
+	})
+}
+
+func (h *MeetingHandler) GetSentimentReport(c *gin.Context) {
+	meetingId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid meeting ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	report, err := h.meetingService.GetSentimentReport(c.Request.Context(), dto.GetMeetingItemsRequest{
+		MeetingID: meetingId,
+		UserID:    c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to get sentiment report",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Sentiment report retrieved successfully",
+		Data:    report,
+	})
+}
+
+func (h *MeetingHandler) ActivateSummary(c *gin.Context) {
+	meetingId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid meeting ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+	summaryId, err := uuid.Parse(c.Param("summaryId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid summary ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	summary, err := h.meetingService.ActivateSummary(c.Request.Context(), dto.ActivateSummaryRequest{
+		MeetingID: meetingId,
+		SummaryID: summaryId,
+		UserID:    c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to activate summary",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Summary activated successfully",
+		Data:    summary,
+	})
+}
+
+func (h *MeetingHandler) RemoveParticipant(c *gin.Context) {
+	meetingId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid meeting ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	err = h.meetingService.RemoveParticipant(c.Request.Context(), dto.RemoveParticipantRequest{
+		MeetingID: meetingId,
+		UserID:    c.MustGet("userId").(string),
+		InviteeID: c.Param("userId"),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to remove participant",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Participant removed successfully",
+	})
+}
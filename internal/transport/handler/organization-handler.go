@@ -0,0 +1,239 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rahulSailesh-shah/converSense/internal/dto"
+	"github.com/rahulSailesh-shah/converSense/internal/service"
+)
+
+type OrganizationHandler struct {
+	organizationService service.OrganizationService
+}
+
+func NewOrganizationHandler(organizationService service.OrganizationService) *OrganizationHandler {
+	return &OrganizationHandler{
+		organizationService: organizationService,
+	}
+}
+
+func (h *OrganizationHandler) CreateOrganization(c *gin.Context) {
+	var req dto.CreateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req.UserID = c.MustGet("userId").(string)
+	organization, err := h.organizationService.CreateOrganization(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to create organization",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Organization created successfully",
+		Data:    organization,
+	})
+}
+
+func (h *OrganizationHandler) GetOrganizations(c *gin.Context) {
+	organizations, err := h.organizationService.GetOrganizations(c.Request.Context(), c.MustGet("userId").(string))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to get organizations",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Organizations retrieved successfully",
+		Data:    organizations,
+	})
+}
+
+func (h *OrganizationHandler) UpdateOrganization(c *gin.Context) {
+	var req dto.UpdateOrganizationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	var err error
+	req.ID, err = uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid organization ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+	req.UserID = c.MustGet("userId").(string)
+	organization, err := h.organizationService.UpdateOrganization(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to update organization",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Organization updated successfully",
+		Data:    organization,
+	})
+}
+
+func (h *OrganizationHandler) DeleteOrganization(c *gin.Context) {
+	orgId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid organization ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	err = h.organizationService.DeleteOrganization(c.Request.Context(), dto.DeleteOrganizationRequest{
+		ID:     orgId,
+		UserID: c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to delete organization",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Organization deleted successfully",
+	})
+}
+
+func (h *OrganizationHandler) GetMembers(c *gin.Context) {
+	orgId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid organization ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	members, err := h.organizationService.GetMembers(c.Request.Context(), dto.GetOrganizationMembersRequest{
+		OrgID:  orgId,
+		UserID: c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to get members",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Members retrieved successfully",
+		Data:    members,
+	})
+}
+
+func (h *OrganizationHandler) AddMember(c *gin.Context) {
+	orgId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid organization ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	var req dto.AddOrganizationMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	req.OrgID = orgId
+	req.UserID = c.MustGet("userId").(string)
+	member, err := h.organizationService.AddMember(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to add member",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Member added successfully",
+		Data:    member,
+	})
+}
+
+func (h *OrganizationHandler) UpdateMemberRole(c *gin.Context) {
+	orgId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid organization ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	var req dto.UpdateOrganizationMemberRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	req.OrgID = orgId
+	req.UserID = c.MustGet("userId").(string)
+	req.MemberID = c.Param("userId")
+	member, err := h.organizationService.UpdateMemberRole(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to update member",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Member updated successfully",
+		Data:    member,
+	})
+}
+
+func (h *OrganizationHandler) RemoveMember(c *gin.Context) {
+	orgId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid organization ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	err = h.organizationService.RemoveMember(c.Request.Context(), dto.RemoveOrganizationMemberRequest{
+		OrgID:    orgId,
+		UserID:   c.MustGet("userId").(string),
+		MemberID: c.Param("userId"),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to remove member",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Member removed successfully",
+	})
+}
@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rahulSailesh-shah/converSense/internal/dto"
+	"github.com/rahulSailesh-shah/converSense/internal/service"
+)
+
+type WebhookHandler struct {
+	webhookService service.WebhookService
+}
+
+func NewWebhookHandler(webhookService service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+func (h *WebhookHandler) CreateEndpoint(c *gin.Context) {
+	var req dto.CreateWebhookEndpointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	req.UserID = c.MustGet("userId").(string)
+	req.OrgID = c.MustGet("orgId").(uuid.UUID)
+	endpoint, err := h.webhookService.CreateEndpoint(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to create webhook",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Webhook created successfully",
+		Data:    endpoint,
+	})
+}
+
+func (h *WebhookHandler) GetEndpoints(c *gin.Context) {
+	endpoints, err := h.webhookService.GetEndpoints(c.Request.Context(),
+		c.MustGet("userId").(string), c.MustGet("orgId").(uuid.UUID))
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to get webhooks",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Webhooks retrieved successfully",
+		Data:    endpoints,
+	})
+}
+
+func (h *WebhookHandler) UpdateEndpoint(c *gin.Context) {
+	var req dto.UpdateWebhookEndpointRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	var err error
+	req.ID, err = uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid webhook ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+	req.UserID = c.MustGet("userId").(string)
+	endpoint, err := h.webhookService.UpdateEndpoint(c.Request.Context(), req)
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to update webhook",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Webhook updated successfully",
+		Data:    endpoint,
+	})
+}
+
+func (h *WebhookHandler) DeleteEndpoint(c *gin.Context) {
+	endpointId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid webhook ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	err = h.webhookService.DeleteEndpoint(c.Request.Context(), dto.DeleteWebhookEndpointRequest{
+		ID:     endpointId,
+		UserID: c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to delete webhook",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Webhook deleted successfully",
+	})
+}
+
+func (h *WebhookHandler) GetDeliveries(c *gin.Context) {
+	endpointId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid webhook ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+	limit, _ := strconv.Atoi(c.DefaultQuery("limit", "20"))
+	page, _ := strconv.Atoi(c.DefaultQuery("page", "1"))
+
+	deliveries, err := h.webhookService.GetDeliveries(c.Request.Context(), dto.GetWebhookDeliveriesRequest{
+		EndpointID: endpointId,
+		UserID:     c.MustGet("userId").(string),
+		Limit:      int32(limit),
+		Offset:     int32((page - 1) * limit),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to get webhook deliveries",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Webhook deliveries retrieved successfully",
+		Data:    deliveries,
+	})
+}
+
+func (h *WebhookHandler) ReplayDelivery(c *gin.Context) {
+	endpointId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid webhook ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+	deliveryId, err := uuid.Parse(c.Param("deliveryId"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Message: "Invalid delivery ID",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	delivery, err := h.webhookService.ReplayDelivery(c.Request.Context(), dto.ReplayWebhookDeliveryRequest{
+		EndpointID: endpointId,
+		DeliveryID: deliveryId,
+		UserID:     c.MustGet("userId").(string),
+	})
+	if err != nil {
+		c.JSON(errorStatus(err), dto.ErrorResponse{
+			Message: "Failed to replay webhook delivery",
+			Error:   err.Error(),
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, dto.SuccessResponse{
+		Message: "Webhook delivery queued successfully",
+		Data:    delivery,
+	})
+}
@@ -2,15 +2,38 @@ package middleware
 
 import (
 	"fmt"
+	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/gin"
-	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/rahulSailesh-shah/converSense/internal/dto"
+	"github.com/rahulSailesh-shah/converSense/internal/service"
+	"github.com/rahulSailesh-shah/converSense/pkg/apikey"
 	"github.com/rahulSailesh-shah/converSense/pkg/auth"
 )
 
-func AuthMiddleware(authKeys jwk.Set) gin.HandlerFunc {
+// APIKeyHeader carries an API key for clients that cannot set a bearer token.
+const APIKeyHeader = "X-API-Key"
+
+// AuthMiddleware accepts either a JWT or an API key. Requests made with an
+// API key also get its scopes set as "apiKeyScopes".
+func AuthMiddleware(verifier *auth.Verifier, apiKeys service.APIKeyService) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		userId, err := auth.UserFromToken(c.Request, authKeys)
+		if key := apiKeyFromRequest(c.Request); key != "" {
+			apiKey, err := apiKeys.Authenticate(c.Request.Context(), key)
+			if err != nil {
+				fmt.Println("Auth error:", err)
+				c.JSON(401, gin.H{"error": "Unauthorized"})
+				c.Abort()
+				return
+			}
+			c.Set("userId", apiKey.UserID)
+			c.Set("apiKeyScopes", apiKey.Scopes)
+			c.Next()
+			return
+		}
+
+		userId, err := verifier.UserFromToken(c.Request)
 		if err != nil {
 			fmt.Println("Auth error:", err)
 			c.JSON(401, gin.H{"error": "Unauthorized"})
@@ -21,3 +44,56 @@ func AuthMiddleware(authKeys jwk.Set) gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+// RequireScope rejects API key requests lacking the scope for the request
+// method: readScope for GET and HEAD, writeScope otherwise. Requests made
+// with a JWT are not affected.
+func RequireScope(readScope string, writeScope string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		scopes, ok := c.Get("apiKeyScopes")
+		if !ok {
+			c.Next()
+			return
+		}
+
+		required := writeScope
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			required = readScope
+		}
+		granted, _ := scopes.([]string)
+		if !apikey.Allows(granted, required) {
+			c.AbortWithStatusJSON(http.StatusForbidden, dto.ErrorResponse{
+				Message: "Forbidden",
+				Error:   fmt.Sprintf("API key is missing the %s scope", required),
+			})
+			return
+		}
+		c.Next()
+	}
+}
+
+// RequireSession rejects requests made with an API key, for endpoints that
+// manage the account itself.
+func RequireSession() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := c.Get("apiKeyScopes"); ok {
+			c.AbortWithStatusJSON(http.StatusForbidden, dto.ErrorResponse{
+				Message: "Forbidden",
+				Error:   "API keys cannot access this endpoint",
+			})
+			return
+		}
+		c.Next()
+	}
+}
+
+func apiKeyFromRequest(r *http.Request) string {
+	if key := r.Header.Get(APIKeyHeader); key != "" {
+		return key
+	}
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if ok && apikey.IsKey(token) {
+		return token
+	}
+	return ""
+}
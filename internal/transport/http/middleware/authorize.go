@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rahulSailesh-shah/converSense/internal/dto"
+	"github.com/rahulSailesh-shah/converSense/pkg/authz"
+)
+
+// Authorize rejects requests whose user may not take action on resource in
+// the active organization. It must run after OrganizationMiddleware.
+// Handlers acting on a single agent or meeting are authorized again by the
+// service against the organization that owns it.
+func Authorize(resource authz.Resource, action authz.Action) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		subject := authz.Subject{
+			UserID: c.GetString("userId"),
+			Role:   c.GetString("orgRole"),
+		}
+		if orgID, ok := c.Get("orgId"); ok {
+			subject.OrgID, _ = orgID.(uuid.UUID)
+		}
+
+		if err := authz.Authorize(subject, resource, action); err != nil {
+			c.AbortWithStatusJSON(http.StatusForbidden, dto.ErrorResponse{
+				Message: "Forbidden",
+				Error:   err.Error(),
+			})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,70 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rahulSailesh-shah/converSense/internal/dto"
+	"github.com/rahulSailesh-shah/converSense/pkg/authz"
+)
+
+func TestAuthorize(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	orgID := uuid.New()
+
+	tests := []struct {
+		name       string
+		userID     string
+		role       string
+		orgID      any
+		action     authz.Action
+		wantStatus int
+	}{
+		{"member creates meeting", "u1", authz.RoleMember, orgID, authz.ActionCreate, http.StatusOK},
+		{"viewer reads meetings", "u1", authz.RoleViewer, orgID, authz.ActionRead, http.StatusOK},
+		{"viewer cannot create meeting", "u1", authz.RoleViewer, orgID, authz.ActionCreate, http.StatusForbidden},
+		{"non-member", "u1", "", orgID, authz.ActionRead, http.StatusForbidden},
+		{"no organization", "u1", authz.RoleOwner, nil, authz.ActionRead, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(func(c *gin.Context) {
+				c.Set("userId", tt.userID)
+				c.Set("orgRole", tt.role)
+				if tt.orgID != nil {
+					c.Set("orgId", tt.orgID)
+				}
+			})
+			handlerCalled := false
+			router.GET("/meetings", Authorize(authz.ResourceMeeting, tt.action), func(c *gin.Context) {
+				handlerCalled = true
+				c.Status(http.StatusOK)
+			})
+
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/meetings", nil))
+
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", recorder.Code, tt.wantStatus)
+			}
+			if tt.wantStatus != http.StatusForbidden {
+				return
+			}
+			if handlerCalled {
+				t.Error("handler ran after a forbidden response")
+			}
+			var body dto.ErrorResponse
+			if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+				t.Fatalf("invalid error body: %v", err)
+			}
+			if body.Message != "Forbidden" || body.Error == "" {
+				t.Errorf("body = %+v, want a Forbidden error", body)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/rahulSailesh-shah/converSense/internal/dto"
+	"github.com/rahulSailesh-shah/converSense/internal/service"
+)
+
+// OrganizationHeader selects the organization a request acts in. Without it
+// the user's first organization is used.
+const OrganizationHeader = "X-Organization-ID"
+
+// OrganizationMiddleware resolves the active organization of an
+// authenticated request and sets "orgId" and "orgRole".
+func OrganizationMiddleware(organizationService service.OrganizationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		orgID := uuid.Nil
+		if header := c.GetHeader(OrganizationHeader); header != "" {
+			var err error
+			orgID, err = uuid.Parse(header)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadRequest, dto.ErrorResponse{
+					Message: "Invalid organization ID",
+					Error:   err.Error(),
+				})
+				return
+			}
+		}
+
+		organization, err := organizationService.ResolveOrganization(c.Request.Context(), c.MustGet("userId").(string), orgID)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusForbidden, dto.ErrorResponse{
+				Message: "Organization not accessible",
+				Error:   err.Error(),
+			})
+			return
+		}
+		c.Set("orgId", organization.ID)
+		c.Set("orgRole", organization.Role)
+		c.Next()
+	}
+}
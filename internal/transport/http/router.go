@@ -5,19 +5,21 @@ import (
 
 	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
-	"github.com/lestrrat-go/jwx/v3/jwk"
 	"github.com/rahulSailesh-shah/converSense/internal/app"
 	"github.com/rahulSailesh-shah/converSense/internal/transport/handler"
 	"github.com/rahulSailesh-shah/converSense/internal/transport/http/middleware"
+	"github.com/rahulSailesh-shah/converSense/pkg/apikey"
+	"github.com/rahulSailesh-shah/converSense/pkg/auth"
+	"github.com/rahulSailesh-shah/converSense/pkg/authz"
 )
 
-func RegisterRoutes(r *gin.Engine, authKeys jwk.Set, app *app.App) {
+func RegisterRoutes(r *gin.Engine, verifier *auth.Verifier, app *app.App) {
 	r.Use(gin.Logger())
 	r.Use(gin.Recovery())
 	r.Use(cors.New(cors.Config{
 		AllowOrigins:     []string{"http://localhost:5173", "http://127.0.0.1:5173", "http://localhost:9001", "http://127.0.0.1:9001"},
-		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization"},
+		AllowMethods:     []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders:     []string{"Origin", "Content-Type", "Accept", "Authorization", middleware.OrganizationHeader, middleware.APIKeyHeader},
 		AllowCredentials: true,
 	}))
 
@@ -29,37 +31,108 @@ func RegisterRoutes(r *gin.Engine, authKeys jwk.Set, app *app.App) {
 
 	// Middlewares
 	protected := r.Group("")
-	protected.Use(middleware.AuthMiddleware(authKeys))
+	protected.Use(middleware.AuthMiddleware(verifier, app.Service.APIKey))
+
+	// Routes acting in the organization selected by the X-Organization-ID header
+	workspace := protected.Group("")
+	workspace.Use(middleware.OrganizationMiddleware(app.Service.Organization))
 
 	// Inngest Endpoint
 	r.Any("/api/inngest", app.Inngest.Handler())
 
 	// Agent routes
-	agentRoutes := protected.Group("/agents")
+	agentRoutes := workspace.Group("/agents")
+	agentRoutes.Use(middleware.RequireScope(apikey.ScopeAgentsRead, apikey.ScopeAgentsWrite))
 	agentHandler := handler.NewAgentHandler(app.Service.Agent)
 	{
-		agentRoutes.POST("", agentHandler.CreateAgent)
+		agentRoutes.POST("", middleware.Authorize(authz.ResourceAgent, authz.ActionCreate), agentHandler.CreateAgent)
 		agentRoutes.PUT("/:id", agentHandler.UpdateAgent)
-		agentRoutes.GET("", agentHandler.GetAgents)
+		agentRoutes.GET("", middleware.Authorize(authz.ResourceAgent, authz.ActionRead), agentHandler.GetAgents)
 		agentRoutes.GET("/:id", agentHandler.GetAgent)
 		agentRoutes.DELETE("/:id", agentHandler.DeleteAgent)
+		agentRoutes.POST("/:id/clone", agentHandler.CloneAgent)
+		agentRoutes.GET("/:id/versions", agentHandler.GetAgentVersions)
+		agentRoutes.GET("/:id/versions/diff", agentHandler.DiffAgentVersions)
+		agentRoutes.POST("/:id/versions/:version/rollback", agentHandler.RollbackAgent)
+		agentRoutes.POST("/:id/documents", agentHandler.UploadAgentDocument)
+		agentRoutes.GET("/:id/documents", agentHandler.GetAgentDocuments)
+		agentRoutes.DELETE("/:id/documents/:documentId", agentHandler.DeleteAgentDocument)
 	}
 
+	workspace.GET("/agent-templates", middleware.RequireScope(apikey.ScopeAgentsRead, apikey.ScopeAgentsWrite), middleware.Authorize(authz.ResourceAgent, authz.ActionRead), agentHandler.GetAgentTemplates)
+	workspace.GET("/summary-templates", middleware.RequireScope(apikey.ScopeAgentsRead, apikey.ScopeAgentsWrite), middleware.Authorize(authz.ResourceAgent, authz.ActionRead), agentHandler.GetSummaryTemplates)
+
 	// Chat routes
 	chatHandler := handler.NewChatHandler(app.Service.Chat)
-	protected.POST("/chat/:meetingId", chatHandler.Chat)
-	protected.GET("/chat/:meetingId", chatHandler.GetHistory)
+	chatRoutes := workspace.Group("/chat")
+	chatRoutes.Use(middleware.RequireScope(apikey.ScopeChat, apikey.ScopeChat))
+	chatRoutes.POST("/:meetingId", chatHandler.Chat)
+	chatRoutes.GET("/:meetingId", chatHandler.GetHistory)
 
 	// Meeting routes
-	meetingRoutes := protected.Group("/meetings")
+	meetingRoutes := workspace.Group("/meetings")
+	meetingRoutes.Use(middleware.RequireScope(apikey.ScopeMeetingsRead, apikey.ScopeMeetingsWrite))
 	meetingHandler := handler.NewMeetingHandler(app.Service.Meeting)
 	{
-		meetingRoutes.POST("", meetingHandler.CreateMeeting)
+		meetingRoutes.POST("", middleware.Authorize(authz.ResourceMeeting, authz.ActionCreate), meetingHandler.CreateMeeting)
 		meetingRoutes.PUT("/:id", meetingHandler.UpdateMeeting)
-		meetingRoutes.GET("", meetingHandler.GetMeetings)
+		meetingRoutes.GET("", middleware.Authorize(authz.ResourceMeeting, authz.ActionRead), meetingHandler.GetMeetings)
 		meetingRoutes.GET("/:id", meetingHandler.GetMeeting)
 		meetingRoutes.DELETE("/:id", meetingHandler.DeleteMeeting)
 		meetingRoutes.POST("/:id/start", meetingHandler.StartMeeting)
 		meetingRoutes.POST("/:id/recording-url", meetingHandler.GetPreSignedRecordingURL)
+		meetingRoutes.POST("/:id/stop", meetingHandler.StopMeeting)
+		meetingRoutes.GET("/:id/live", meetingHandler.GetLiveSession)
+		meetingRoutes.GET("/:id/notes", meetingHandler.GetMeetingNotes)
+		meetingRoutes.GET("/:id/action-items", meetingHandler.GetActionItems)
+		meetingRoutes.PATCH("/:id/action-items/:itemId", meetingHandler.UpdateActionItem)
+		meetingRoutes.GET("/:id/decisions", meetingHandler.GetDecisions)
+		meetingRoutes.GET("/:id/questions", meetingHandler.GetOpenQuestions)
+		meetingRoutes.POST("/:id/reprocess", meetingHandler.ReprocessMeeting)
+		meetingRoutes.GET("/:id/summaries", meetingHandler.GetSummaries)
+		meetingRoutes.POST("/:id/summaries/:summaryId/activate", meetingHandler.ActivateSummary)
+		meetingRoutes.GET("/:id/sentiment", meetingHandler.GetSentimentReport)
+		meetingRoutes.POST("/:id/join", meetingHandler.JoinMeeting)
+		meetingRoutes.POST("/:id/participants", meetingHandler.InviteParticipant)
+		meetingRoutes.GET("/:id/participants", meetingHandler.GetParticipants)
+		meetingRoutes.DELETE("/:id/participants/:userId", meetingHandler.RemoveParticipant)
+	}
+
+	// Webhook routes
+	webhookRoutes := workspace.Group("/webhooks")
+	webhookRoutes.Use(middleware.RequireSession())
+	webhookHandler := handler.NewWebhookHandler(app.Service.Webhook)
+	{
+		webhookRoutes.POST("", middleware.Authorize(authz.ResourceWebhook, authz.ActionCreate), webhookHandler.CreateEndpoint)
+		webhookRoutes.GET("", middleware.Authorize(authz.ResourceWebhook, authz.ActionRead), webhookHandler.GetEndpoints)
+		webhookRoutes.PUT("/:id", webhookHandler.UpdateEndpoint)
+		webhookRoutes.DELETE("/:id", webhookHandler.DeleteEndpoint)
+		webhookRoutes.GET("/:id/deliveries", webhookHandler.GetDeliveries)
+		webhookRoutes.POST("/:id/deliveries/:deliveryId/replay", webhookHandler.ReplayDelivery)
+	}
+
+	// Organization routes
+	organizationRoutes := protected.Group("/organizations")
+	organizationRoutes.Use(middleware.RequireSession())
+	organizationHandler := handler.NewOrganizationHandler(app.Service.Organization)
+	{
+		organizationRoutes.POST("", organizationHandler.CreateOrganization)
+		organizationRoutes.GET("", organizationHandler.GetOrganizations)
+		organizationRoutes.PUT("/:id", organizationHandler.UpdateOrganization)
+		organizationRoutes.DELETE("/:id", organizationHandler.DeleteOrganization)
+		organizationRoutes.GET("/:id/members", organizationHandler.GetMembers)
+		organizationRoutes.POST("/:id/members", organizationHandler.AddMember)
+		organizationRoutes.PUT("/:id/members/:userId", organizationHandler.UpdateMemberRole)
+		organizationRoutes.DELETE("/:id/members/:userId", organizationHandler.RemoveMember)
+	}
+
+	// API key routes
+	apiKeyRoutes := protected.Group("/api-keys")
+	apiKeyRoutes.Use(middleware.RequireSession())
+	apiKeyHandler := handler.NewAPIKeyHandler(app.Service.APIKey)
+	{
+		apiKeyRoutes.POST("", apiKeyHandler.CreateAPIKey)
+		apiKeyRoutes.GET("", apiKeyHandler.GetAPIKeys)
+		apiKeyRoutes.DELETE("/:id", apiKeyHandler.RevokeAPIKey)
 	}
 }
@@ -0,0 +1,90 @@
+package agentconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+)
+
+// Response modalities an agent can answer in.
+const (
+	ModalityAudio = "audio"
+	ModalityText  = "text"
+)
+
+const (
+	maxTemperature    = 2.0
+	maxThinkingBudget = 24576
+)
+
+var (
+	voiceNamePattern    = regexp.MustCompile(`^[A-Za-z]{1,32}$`)
+	languageCodePattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+	modelNamePattern    = regexp.MustCompile(`^[a-z0-9][a-z0-9.\-]{0,127}$`)
+)
+
+// Settings controls how an agent sounds and which model runs it. Zero
+// values fall back to the server defaults.
+type Settings struct {
+	VoiceName        string   `json:"voiceName,omitempty"`        // Gemini prebuilt voice, e.g. "Puck"
+	LanguageCode     string   `json:"languageCode,omitempty"`     // BCP-47, e.g. "en-US"
+	RealtimeModel    string   `json:"realtimeModel,omitempty"`    // Gemini Live model name
+	ResponseModality string   `json:"responseModality,omitempty"` // "audio" or "text"
+	Temperature      *float32 `json:"temperature,omitempty"`
+	ThinkingBudget   *int32   `json:"thinkingBudget,omitempty"` // -1 lets the model decide, 0 disables thinking
+
+	// SummaryTemplate names the built-in template meeting summaries follow;
+	// SummarySections, when set, replace it with the agent's own sections.
+	SummaryTemplate string           `json:"summaryTemplate,omitempty"`
+	SummarySections []SummarySection `json:"summarySections,omitempty"`
+}
+
+// ParseSettings decodes the stored settings; NULL yields the zero Settings.
+func ParseSettings(data []byte) (Settings, error) {
+	var settings Settings
+	if data == nil {
+		return settings, nil
+	}
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return settings, fmt.Errorf("invalid agent settings: %w", err)
+	}
+	return settings, nil
+}
+
+// MarshalSettings encodes settings for storage after validating them.
+func MarshalSettings(settings Settings) ([]byte, error) {
+	if err := ValidateSettings(settings); err != nil {
+		return nil, err
+	}
+	return json.Marshal(settings)
+}
+
+// ValidateSettings checks each set field; empty fields are always valid.
+func ValidateSettings(settings Settings) error {
+	if settings.VoiceName != "" && !voiceNamePattern.MatchString(settings.VoiceName) {
+		return fmt.Errorf("invalid voice name %q", settings.VoiceName)
+	}
+	if settings.LanguageCode != "" && !languageCodePattern.MatchString(settings.LanguageCode) {
+		return fmt.Errorf("invalid language code %q, expected e.g. en-US", settings.LanguageCode)
+	}
+	if settings.RealtimeModel != "" && !modelNamePattern.MatchString(settings.RealtimeModel) {
+		return fmt.Errorf("invalid realtime model %q", settings.RealtimeModel)
+	}
+	switch settings.ResponseModality {
+	case "", ModalityAudio, ModalityText:
+	default:
+		return fmt.Errorf("response modality must be %q or %q", ModalityAudio, ModalityText)
+	}
+	if t := settings.Temperature; t != nil && (*t < 0 || *t > maxTemperature) {
+		return fmt.Errorf("temperature must be between 0 and %.0f", maxTemperature)
+	}
+	if b := settings.ThinkingBudget; b != nil && (*b < -1 || *b > maxThinkingBudget) {
+		return fmt.Errorf("thinking budget must be between -1 and %d", maxThinkingBudget)
+	}
+	if settings.SummaryTemplate != "" {
+		if _, ok := GetSummaryTemplate(settings.SummaryTemplate); !ok {
+			return fmt.Errorf("unknown summary template %q", settings.SummaryTemplate)
+		}
+	}
+	return validateSummarySections(settings.SummarySections)
+}
@@ -0,0 +1,213 @@
+package agentconfig
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultSummaryTemplate is used when an agent picks no summary template.
+const DefaultSummaryTemplate = "general"
+
+const (
+	maxSummarySections         = 10
+	maxSummaryTitleLength      = 100
+	maxSummaryInstructionsSize = 1000
+)
+
+// SummarySection is one heading of a meeting summary and what goes under it.
+type SummarySection struct {
+	Title        string `json:"title"`
+	Instructions string `json:"instructions"`
+}
+
+// SummaryTemplate shapes the summary written after a meeting.
+type SummaryTemplate struct {
+	ID          string           `json:"id"`
+	Name        string           `json:"name"`
+	Description string           `json:"description"`
+	Sections    []SummarySection `json:"sections"`
+}
+
+var summaryTemplates = []SummaryTemplate{
+	{
+		ID:          DefaultSummaryTemplate,
+		Name:        "General",
+		Description: "A narrative overview followed by thematic notes.",
+		Sections: []SummarySection{
+			{
+				Title:        "Overview",
+				Instructions: "Provide a detailed, engaging summary of the session's content. Focus on major features, user workflows, and any key takeaways. Write in a narrative style, using full sentences. Highlight unique or powerful aspects of the product, platform, or discussion.",
+			},
+			{
+				Title:        "Notes",
+				Instructions: "Break down key content into thematic sections with timestamp ranges, each as a \"#### HH:MM:SS - HH:MM:SS Section Name\" heading. Each section should summarize key points, actions, or demos in bullet format.",
+			},
+		},
+	},
+	{
+		ID:          "standup",
+		Name:        "Standup",
+		Description: "Per-person updates, blockers and follow-ups.",
+		Sections: []SummarySection{
+			{
+				Title:        "Updates",
+				Instructions: "One bullet list per participant with what they did since the last standup and what they will do next.",
+			},
+			{
+				Title:        "Blockers",
+				Instructions: "Every blocker raised, who is blocked and who can help. Write \"None\" if there were no blockers.",
+			},
+			{
+				Title:        "Follow-ups",
+				Instructions: "Discussions that were taken offline, with the people involved.",
+			},
+		},
+	},
+	{
+		ID:          "interview-scorecard",
+		Name:        "Interview Scorecard",
+		Description: "A candidate scorecard with evidence for each rating.",
+		Sections: []SummarySection{
+			{
+				Title:        "Candidate Summary",
+				Instructions: "Two or three sentences on the candidate's background and the role discussed.",
+			},
+			{
+				Title:        "Scorecard",
+				Instructions: "Rate communication, technical depth, problem solving, collaboration and role fit from 1 to 5. Give each rating as a bullet with one sentence of evidence quoting or paraphrasing the candidate, with its timestamp.",
+			},
+			{
+				Title:        "Strengths",
+				Instructions: "The strongest signals in the candidate's favour, as bullets.",
+			},
+			{
+				Title:        "Concerns",
+				Instructions: "Gaps, vague answers or red flags worth probing in later rounds, as bullets.",
+			},
+			{
+				Title:        "Recommendation",
+				Instructions: "One of Strong Hire, Hire, No Hire or Strong No Hire, with a one-paragraph justification. Do not comment on anything unrelated to the role.",
+			},
+		},
+	},
+	{
+		ID:          "sales-call",
+		Name:        "Sales Call",
+		Description: "Prospect needs, objections and next steps.",
+		Sections: []SummarySection{
+			{
+				Title:        "Prospect",
+				Instructions: "Who the prospect is, their company and their role in the buying decision.",
+			},
+			{
+				Title:        "Needs and Pain Points",
+				Instructions: "What problem the prospect is trying to solve and why now, as bullets.",
+			},
+			{
+				Title:        "Objections",
+				Instructions: "Each objection raised, with its timestamp and how it was handled.",
+			},
+			{
+				Title:        "Next Steps",
+				Instructions: "Agreed next steps with owners and dates, and the likelihood the deal moves forward.",
+			},
+		},
+	},
+	{
+		ID:          "lecture-notes",
+		Name:        "Lecture Notes",
+		Description: "Study notes with key concepts and review questions.",
+		Sections: []SummarySection{
+			{
+				Title:        "Key Concepts",
+				Instructions: "Each concept taught, with a short definition in plain language.",
+			},
+			{
+				Title:        "Notes",
+				Instructions: "The lecture in order, as \"#### HH:MM:SS - HH:MM:SS Topic\" headings with bullet points, including examples given.",
+			},
+			{
+				Title:        "Review Questions",
+				Instructions: "Three to five questions a student could use to check their understanding.",
+			},
+		},
+	},
+	{
+		ID:          "one-on-one",
+		Name:        "1:1",
+		Description: "Topics, feedback and commitments from a one-on-one.",
+		Sections: []SummarySection{
+			{
+				Title:        "Topics Discussed",
+				Instructions: "Each topic with the key points from both people, as bullets.",
+			},
+			{
+				Title:        "Feedback",
+				Instructions: "Feedback given in either direction, attributed to who gave it.",
+			},
+			{
+				Title:        "Commitments",
+				Instructions: "What each person agreed to do before the next one-on-one.",
+			},
+		},
+	},
+}
+
+// SummaryTemplates returns the built-in summary templates.
+func SummaryTemplates() []SummaryTemplate {
+	return summaryTemplates
+}
+
+// GetSummaryTemplate returns the built-in summary template with the given ID.
+func GetSummaryTemplate(id string) (SummaryTemplate, bool) {
+	for _, template := range summaryTemplates {
+		if template.ID == id {
+			return template, true
+		}
+	}
+	return SummaryTemplate{}, false
+}
+
+// SummaryTemplateFor returns the template an agent's summaries follow: its
+// own sections when it defines any, else its chosen template, else the
+// default.
+func SummaryTemplateFor(settings Settings) SummaryTemplate {
+	if len(settings.SummarySections) > 0 {
+		return SummaryTemplate{
+			ID:       "custom",
+			Name:     "Custom",
+			Sections: settings.SummarySections,
+		}
+	}
+	if template, ok := GetSummaryTemplate(settings.SummaryTemplate); ok {
+		return template
+	}
+	template, _ := GetSummaryTemplate(DefaultSummaryTemplate)
+	return template
+}
+
+// Render formats the template as the markdown structure the model is asked
+// to follow.
+func (t SummaryTemplate) Render() string {
+	var out strings.Builder
+	for _, section := range t.Sections {
+		fmt.Fprintf(&out, "### %s\n%s\n\n", section.Title, section.Instructions)
+	}
+	return out.String()
+}
+
+func validateSummarySections(sections []SummarySection) error {
+	if len(sections) > maxSummarySections {
+		return fmt.Errorf("at most %d summary sections are allowed", maxSummarySections)
+	}
+	for _, section := range sections {
+		title := strings.TrimSpace(section.Title)
+		if title == "" || len(title) > maxSummaryTitleLength || strings.ContainsAny(title, "\n#") {
+			return fmt.Errorf("summary section titles must be 1-%d characters on one line without '#'", maxSummaryTitleLength)
+		}
+		if strings.TrimSpace(section.Instructions) == "" || len(section.Instructions) > maxSummaryInstructionsSize {
+			return fmt.Errorf("summary section %q needs instructions of at most %d characters", title, maxSummaryInstructionsSize)
+		}
+	}
+	return nil
+}
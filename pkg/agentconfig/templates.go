@@ -0,0 +1,102 @@
+package agentconfig
+
+// Template is a ready-made agent configuration users can start from.
+type Template struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	Instructions string   `json:"instructions"`
+	Tools        []Tool   `json:"tools"`
+	Settings     Settings `json:"settings"`
+}
+
+var templates = []Template{
+	{
+		ID:          "interviewer",
+		Name:        "Interviewer",
+		Description: "Runs a structured job interview and records the candidate's answers.",
+		Instructions: `You are a professional, friendly interviewer running a structured job interview.
+Start by introducing yourself and explaining the format. Ask one question at a time and wait for the candidate to finish before moving on.
+Cover the candidate's background, a recent project they are proud of, how they handle disagreement, and one role-specific problem.
+Ask a follow-up question whenever an answer is vague. Do not give feedback on answers during the interview.
+Take a note of each key answer. Close by inviting the candidate's questions and explaining the next steps.`,
+		Tools: []Tool{
+			{Type: ToolTakeNote},
+			{Type: ToolSearchKnowledgeBase},
+		},
+		Settings: Settings{
+			SummaryTemplate: "interview-scorecard",
+		},
+	},
+	{
+		ID:          "standup-facilitator",
+		Name:        "Standup Facilitator",
+		Description: "Keeps daily standups short and captures blockers and follow-ups.",
+		Instructions: `You facilitate a short daily standup.
+Go around the participants one at a time and ask what they did since the last standup, what they will do next and whether anything is blocking them.
+Keep each update brief and politely steer longer discussions to a follow-up.
+Create an action item for every blocker or follow-up, with its owner.
+At the end, recap the blockers and action items in a few sentences.`,
+		Tools: []Tool{
+			{Type: ToolCreateActionItem},
+			{Type: ToolSetReminder},
+			{Type: ToolLookupMeetingSummary},
+		},
+		Settings: Settings{
+			ResponseModality: ModalityAudio,
+			SummaryTemplate:  "standup",
+		},
+	},
+	{
+		ID:          "sales-coach",
+		Name:        "Sales Coach",
+		Description: "Role-plays a prospect and coaches the user on their pitch.",
+		Instructions: `You are an experienced sales coach.
+First ask the user what they are selling and who the prospect is. Then role-play that prospect realistically, raising common objections about price, timing and competitors.
+When the user asks for feedback, or after about ten minutes, step out of the role-play and give specific coaching: what worked, what to improve and a better phrasing for one weak moment.
+Be encouraging but honest.`,
+		Tools: []Tool{
+			{Type: ToolTakeNote},
+			{Type: ToolSearchKnowledgeBase},
+		},
+		Settings: Settings{
+			ResponseModality: ModalityAudio,
+			SummaryTemplate:  "sales-call",
+		},
+	},
+	{
+		ID:          "language-tutor",
+		Name:        "Language Tutor",
+		Description: "Holds a conversation in the target language and corrects mistakes gently.",
+		Instructions: `You are a patient language tutor.
+Hold a natural conversation with the learner in the target language, adapting your vocabulary and speed to their level.
+When the learner makes a mistake, repeat their sentence correctly and briefly explain the correction, then continue the conversation.
+Introduce a few useful new words during the session and note them down so the learner can review them later.`,
+		Tools: []Tool{
+			{Type: ToolTakeNote},
+		},
+		Settings: Settings{
+			ResponseModality: ModalityAudio,
+			LanguageCode:     "es-ES",
+			SummaryTemplate:  "lecture-notes",
+		},
+	},
+	{
+		ID:          "note-taker",
+		Name:        "Note Taker",
+		Description: "Stays quiet and keeps the meeting record: notes, decisions and action items.",
+		Instructions: `You are a silent note-taker.
+Do not speak unless a participant addresses you directly. When addressed, answer in one or two sentences.
+Listen for decisions, commitments and important facts. Record decisions and facts as notes and every commitment as an action item with its owner and due date when mentioned.`,
+		Tools: []Tool{
+			{Type: ToolTakeNote},
+			{Type: ToolCreateActionItem},
+			{Type: ToolSetReminder},
+		},
+	},
+}
+
+// Templates returns the built-in agent templates.
+func Templates() []Template {
+	return templates
+}
@@ -0,0 +1,229 @@
+// Package agentconfig defines the per-agent configuration stored alongside
+// an agent and how it is validated.
+package agentconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/rahulSailesh-shah/converSense/pkg/safehttp"
+)
+
+// Tool types an agent can enable.
+const (
+	ToolGoogleSearch         = "google_search"
+	ToolTakeNote             = "take_note"
+	ToolSetReminder          = "set_reminder"
+	ToolCreateActionItem     = "create_action_item"
+	ToolLookupMeetingSummary = "lookup_meeting_summary"
+	ToolSearchKnowledgeBase  = "search_knowledge_base"
+	ToolHTTPWebhook          = "http_webhook"
+)
+
+const maxWebhookTimeoutSec = 10
+
+var (
+	builtinToolTypes = []string{
+		ToolGoogleSearch,
+		ToolTakeNote,
+		ToolSetReminder,
+		ToolCreateActionItem,
+		ToolLookupMeetingSummary,
+		ToolSearchKnowledgeBase,
+	}
+	functionNamePattern   = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]{0,63}$`)
+	webhookParameterTypes = map[string]bool{
+		"string":  true,
+		"number":  true,
+		"integer": true,
+		"boolean": true,
+	}
+)
+
+// Tool enables one capability for an agent's meetings.
+type Tool struct {
+	Type    string         `json:"type"`
+	Webhook *WebhookConfig `json:"webhook,omitempty"` // only for http_webhook
+}
+
+// WebhookConfig exposes an HTTP endpoint to the agent as a callable function.
+// The model's arguments are POSTed as JSON and the response body is handed
+// back to the model. Headers often carry credentials, so they are
+// write-only: RedactTools strips them before tools are shown to users.
+type WebhookConfig struct {
+	Name        string                      `json:"name"`
+	Description string                      `json:"description"`
+	URL         string                      `json:"url"`
+	Method      string                      `json:"method,omitempty"` // POST by default
+	Headers     map[string]string           `json:"headers,omitempty"`
+	Parameters  map[string]WebhookParameter `json:"parameters,omitempty"`
+	Required    []string                    `json:"required,omitempty"`
+	TimeoutSec  int                         `json:"timeoutSec,omitempty"`
+}
+
+type WebhookParameter struct {
+	Type        string `json:"type"` // string, number, integer or boolean
+	Description string `json:"description,omitempty"`
+}
+
+// DefaultTools is what an agent gets when it has never configured tools.
+func DefaultTools() []Tool {
+	tools := make([]Tool, 0, len(builtinToolTypes))
+	for _, toolType := range builtinToolTypes {
+		tools = append(tools, Tool{Type: toolType})
+	}
+	return tools
+}
+
+// ParseTools decodes the stored tool configuration. A NULL column means the
+// agent predates tool configuration and gets DefaultTools.
+func ParseTools(data []byte) ([]Tool, error) {
+	if data == nil {
+		return DefaultTools(), nil
+	}
+	tools := []Tool{}
+	if err := json.Unmarshal(data, &tools); err != nil {
+		return nil, fmt.Errorf("invalid agent tools: %w", err)
+	}
+	return tools, nil
+}
+
+// MarshalTools encodes tools for storage after validating them.
+func MarshalTools(tools []Tool) ([]byte, error) {
+	if err := ValidateTools(tools); err != nil {
+		return nil, err
+	}
+	if tools == nil {
+		tools = []Tool{}
+	}
+	return json.Marshal(tools)
+}
+
+// ValidateTools checks that every tool is known, built-in tools appear at
+// most once and webhook definitions are complete.
+func ValidateTools(tools []Tool) error {
+	seen := make(map[string]bool)
+	for i, tool := range tools {
+		switch {
+		case tool.Type == ToolHTTPWebhook:
+			if tool.Webhook == nil {
+				return fmt.Errorf("tools[%d]: webhook config is required", i)
+			}
+			if err := validateWebhook(tool.Webhook); err != nil {
+				return fmt.Errorf("tools[%d]: %w", i, err)
+			}
+			if seen[tool.Webhook.Name] {
+				return fmt.Errorf("tools[%d]: duplicate tool name %q", i, tool.Webhook.Name)
+			}
+			seen[tool.Webhook.Name] = true
+		case isBuiltinTool(tool.Type):
+			if tool.Webhook != nil {
+				return fmt.Errorf("tools[%d]: webhook config is only allowed for %s", i, ToolHTTPWebhook)
+			}
+			if seen[tool.Type] {
+				return fmt.Errorf("tools[%d]: duplicate tool %q", i, tool.Type)
+			}
+			seen[tool.Type] = true
+		default:
+			return fmt.Errorf("tools[%d]: unknown tool type %q", i, tool.Type)
+		}
+	}
+	return nil
+}
+
+func validateWebhook(webhook *WebhookConfig) error {
+	if !functionNamePattern.MatchString(webhook.Name) {
+		return fmt.Errorf("webhook name must start with a letter or underscore and contain only letters, digits and underscores")
+	}
+	if isBuiltinTool(webhook.Name) {
+		return fmt.Errorf("webhook name %q is reserved", webhook.Name)
+	}
+	if strings.TrimSpace(webhook.Description) == "" {
+		return fmt.Errorf("webhook description is required")
+	}
+
+	if err := safehttp.ValidateURL(webhook.URL); err != nil {
+		return fmt.Errorf("invalid webhook url: %w", err)
+	}
+
+	switch strings.ToUpper(webhook.Method) {
+	case "", "POST", "PUT", "PATCH":
+	default:
+		return fmt.Errorf("webhook method must be POST, PUT or PATCH")
+	}
+
+	for name, parameter := range webhook.Parameters {
+		if !functionNamePattern.MatchString(name) {
+			return fmt.Errorf("invalid webhook parameter name %q", name)
+		}
+		if !webhookParameterTypes[parameter.Type] {
+			return fmt.Errorf("webhook parameter %q has unsupported type %q", name, parameter.Type)
+		}
+	}
+	for _, name := range webhook.Required {
+		if _, ok := webhook.Parameters[name]; !ok {
+			return fmt.Errorf("required webhook parameter %q is not defined", name)
+		}
+	}
+
+	if webhook.TimeoutSec < 0 || webhook.TimeoutSec > maxWebhookTimeoutSec {
+		return fmt.Errorf("webhook timeoutSec must be between 0 and %d", maxWebhookTimeoutSec)
+	}
+	return nil
+}
+
+// RedactTools returns a copy of tools with webhook headers removed.
+func RedactTools(tools []Tool) []Tool {
+	redacted := make([]Tool, len(tools))
+	for i, tool := range tools {
+		redacted[i] = tool
+		if tool.Webhook != nil {
+			webhook := *tool.Webhook
+			webhook.Headers = nil
+			redacted[i].Webhook = &webhook
+		}
+	}
+	return redacted
+}
+
+// KeepWebhookHeaders copies the stored headers of a webhook onto an updated
+// webhook that leaves them out. Clients only ever see redacted tools, so
+// resending them must not wipe the headers. Headers are only kept when the
+// name, URL and method are unchanged; pointing a webhook somewhere else
+// requires sending its headers again so stored credentials cannot be
+// redirected.
+func KeepWebhookHeaders(updated []Tool, current []Tool) {
+	headers := make(map[webhookTarget]map[string]string)
+	for _, tool := range current {
+		if tool.Webhook != nil {
+			headers[targetOf(tool.Webhook)] = tool.Webhook.Headers
+		}
+	}
+	for _, tool := range updated {
+		if tool.Webhook != nil && tool.Webhook.Headers == nil {
+			tool.Webhook.Headers = headers[targetOf(tool.Webhook)]
+		}
+	}
+}
+
+// webhookTarget is where a webhook's headers are sent.
+type webhookTarget struct {
+	name   string
+	url    string
+	method string
+}
+
+func targetOf(webhook *WebhookConfig) webhookTarget {
+	method := strings.ToUpper(webhook.Method)
+	if method == "" {
+		method = "POST"
+	}
+	return webhookTarget{name: webhook.Name, url: webhook.URL, method: method}
+}
+
+func isBuiltinTool(toolType string) bool {
+	return slices.Contains(builtinToolTypes, toolType)
+}
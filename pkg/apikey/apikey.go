@@ -0,0 +1,113 @@
+// Package apikey generates personal API keys and checks their scopes.
+//
+// A key looks like "cs_<prefix>_<secret>". The prefix identifies the key and
+// is stored in plain text; only the SHA-256 hash of the whole key is kept.
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+// KeyPrefix starts every API key, telling them apart from JWTs.
+const KeyPrefix = "cs_"
+
+const (
+	prefixBytes = 6
+	secretBytes = 24
+)
+
+// Scopes an API key can be granted. A "<resource>:*" scope grants both read
+// and write access to the resource.
+const (
+	ScopeMeetingsRead  = "meetings:read"
+	ScopeMeetingsWrite = "meetings:write"
+	ScopeMeetingsAll   = "meetings:*"
+	ScopeAgentsRead    = "agents:read"
+	ScopeAgentsWrite   = "agents:write"
+	ScopeAgentsAll     = "agents:*"
+	ScopeChat          = "chat"
+)
+
+var validScopes = map[string]bool{
+	ScopeMeetingsRead:  true,
+	ScopeMeetingsWrite: true,
+	ScopeMeetingsAll:   true,
+	ScopeAgentsRead:    true,
+	ScopeAgentsWrite:   true,
+	ScopeAgentsAll:     true,
+	ScopeChat:          true,
+}
+
+// Generate returns a new key together with its prefix and hash. The key is
+// shown to the user once and never stored.
+func Generate() (key string, prefix string, hash string, err error) {
+	prefixRaw := make([]byte, prefixBytes)
+	if _, err := rand.Read(prefixRaw); err != nil {
+		return "", "", "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+	secretRaw := make([]byte, secretBytes)
+	if _, err := rand.Read(secretRaw); err != nil {
+		return "", "", "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+
+	prefix = KeyPrefix + hex.EncodeToString(prefixRaw)
+	key = prefix + "_" + hex.EncodeToString(secretRaw)
+	return key, prefix, Hash(key), nil
+}
+
+// Hash returns the hex SHA-256 of a key.
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsKey reports whether a bearer token is an API key rather than a JWT.
+func IsKey(token string) bool {
+	return strings.HasPrefix(token, KeyPrefix)
+}
+
+// PrefixOf returns the identifying prefix of a key.
+func PrefixOf(key string) (string, error) {
+	if !IsKey(key) {
+		return "", fmt.Errorf("malformed API key")
+	}
+	i := strings.LastIndex(key, "_")
+	if i <= len(KeyPrefix) {
+		return "", fmt.Errorf("malformed API key")
+	}
+	return key[:i], nil
+}
+
+// Matches reports whether key hashes to hash, in constant time.
+func Matches(key string, hash string) bool {
+	return subtle.ConstantTimeCompare([]byte(Hash(key)), []byte(hash)) == 1
+}
+
+// ValidateScopes checks that scopes is non-empty and only holds known scopes.
+func ValidateScopes(scopes []string) error {
+	if len(scopes) == 0 {
+		return fmt.Errorf("at least one scope is required")
+	}
+	for _, scope := range scopes {
+		if !validScopes[scope] {
+			return fmt.Errorf("unknown scope %q", scope)
+		}
+	}
+	return nil
+}
+
+// Allows reports whether the granted scopes cover the required one.
+func Allows(granted []string, required string) bool {
+	resource, _, _ := strings.Cut(required, ":")
+	for _, scope := range granted {
+		if scope == required || scope == resource+":*" {
+			return true
+		}
+	}
+	return false
+}
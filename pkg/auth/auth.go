@@ -2,30 +2,176 @@ package auth
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 
+	"github.com/lestrrat-go/httprc/v3"
 	"github.com/lestrrat-go/jwx/v3/jwk"
+	"github.com/lestrrat-go/jwx/v3/jws"
 	"github.com/lestrrat-go/jwx/v3/jwt"
 )
 
+// Issuer is a trusted token issuer. An empty URL accepts tokens from any
+// issuer not claimed by another entry, which keeps single-issuer setups
+// working without knowing the issuer up front. An empty Audience skips the
+// audience check.
+//
+// Namespace is prefixed to the subjects of the issuer's tokens, so a
+// subject minted by one issuer can never act as a user of another. It is
+// left empty only for the issuer that owns the local user IDs.
+type Issuer struct {
+	URL       string
+	JwksURL   string
+	Audience  string
+	Namespace string
+}
+
+// refetchInterval bounds how often an unknown key ID may force a JWKS fetch,
+// so tokens with made-up key IDs cannot hammer the auth server.
+const refetchInterval = time.Minute
+
+// Verifier validates JWTs against the cached key sets of its issuers. Key
+// sets are refreshed in the background and refetched early when a token is
+// signed with a key ID the cache does not know yet.
+type Verifier struct {
+	cache   *jwk.Cache
+	issuers []Issuer
+
+	mu          sync.Mutex
+	lastRefetch map[string]time.Time
+}
 
-func LoadKeys(jwksURL string) (jwk.Set, error) {
-	ctx:= context.Background()
-	ks, err := jwk.Fetch(ctx, jwksURL)
+// NewVerifier fetches the key set of every issuer and keeps them refreshed
+// at most every refreshInterval until ctx is cancelled.
+func NewVerifier(ctx context.Context, issuers []Issuer, refreshInterval time.Duration) (*Verifier, error) {
+	if len(issuers) == 0 {
+		return nil, fmt.Errorf("no token issuers configured")
+	}
+	cache, err := jwk.NewCache(ctx, httprc.NewClient())
 	if err != nil {
 		return nil, err
 	}
-	return ks, nil
+	for _, issuer := range issuers {
+		if cache.IsRegistered(ctx, issuer.JwksURL) {
+			continue
+		}
+		err := cache.Register(ctx, issuer.JwksURL,
+			jwk.WithMinInterval(refetchInterval),
+			jwk.WithMaxInterval(refreshInterval),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load keys from %s: %w", issuer.JwksURL, err)
+		}
+	}
+	return &Verifier{
+		cache:       cache,
+		issuers:     issuers,
+		lastRefetch: make(map[string]time.Time),
+	}, nil
 }
 
-func UserFromToken(r *http.Request, authKeys jwk.Set) (string, error) {
-	token, err := jwt.ParseRequest(r, jwt.WithKeySet(authKeys))
+// UserFromToken validates the bearer token of r and returns its subject,
+// namespaced by its issuer.
+func (v *Verifier) UserFromToken(r *http.Request) (string, error) {
+	raw, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok || raw == "" {
+		return "", fmt.Errorf("missing bearer token")
+	}
+
+	unverified, err := jwt.ParseInsecure([]byte(raw))
 	if err != nil {
 		return "", err
 	}
-	userId, exists := token.Subject()
-	if !exists {
-		return "", nil
+	iss, _ := unverified.Issuer()
+	issuer, ok := v.issuerFor(iss)
+	if !ok {
+		return "", fmt.Errorf("untrusted token issuer %q", iss)
+	}
+
+	ctx := r.Context()
+	keys, err := v.cache.Lookup(ctx, issuer.JwksURL)
+	if err != nil {
+		return "", err
+	}
+	if kid := keyID(raw); kid != "" {
+		if _, found := keys.LookupKeyID(kid); !found {
+			keys = v.refetch(ctx, issuer.JwksURL, keys)
+		}
+	}
+
+	options := []jwt.ParseOption{jwt.WithKeySet(keys)}
+	if issuer.URL != "" {
+		options = append(options, jwt.WithIssuer(issuer.URL))
+	}
+	if issuer.Audience != "" {
+		options = append(options, jwt.WithAudience(issuer.Audience))
+	}
+	token, err := jwt.ParseString(raw, options...)
+	if err != nil {
+		return "", err
+	}
+	subject, exists := token.Subject()
+	if !exists || subject == "" {
+		return "", fmt.Errorf("token has no subject")
+	}
+	return issuer.userID(subject), nil
+}
+
+// userID maps a token subject to the user ID it stands for.
+func (i Issuer) userID(subject string) string {
+	if i.Namespace == "" {
+		return subject
+	}
+	return i.Namespace + "|" + subject
+}
+
+// issuerFor picks the issuer matching iss, falling back to one without a URL.
+func (v *Verifier) issuerFor(iss string) (Issuer, bool) {
+	var fallback *Issuer
+	for i, issuer := range v.issuers {
+		if issuer.URL == "" {
+			if fallback == nil {
+				fallback = &v.issuers[i]
+			}
+			continue
+		}
+		if issuer.URL == iss {
+			return issuer, true
+		}
+	}
+	if fallback == nil {
+		return Issuer{}, false
+	}
+	return *fallback, true
+}
+
+// refetch refreshes a key set after an unknown key ID, returning the cached
+// set when it was refetched too recently or the fetch fails.
+func (v *Verifier) refetch(ctx context.Context, jwksURL string, cached jwk.Set) jwk.Set {
+	v.mu.Lock()
+	if time.Since(v.lastRefetch[jwksURL]) < refetchInterval {
+		v.mu.Unlock()
+		return cached
+	}
+	v.lastRefetch[jwksURL] = time.Now()
+	v.mu.Unlock()
+
+	keys, err := v.cache.Refresh(ctx, jwksURL)
+	if err != nil {
+		fmt.Printf("[ERROR] Failed to refetch keys from %s: %v\n", jwksURL, err)
+		return cached
+	}
+	return keys
+}
+
+func keyID(raw string) string {
+	msg, err := jws.Parse([]byte(raw))
+	if err != nil || len(msg.Signatures()) == 0 {
+		return ""
 	}
-	return userId, nil
+	kid, _ := msg.Signatures()[0].ProtectedHeaders().KeyID()
+	return kid
 }
@@ -0,0 +1,150 @@
+// Package authz decides whether a subject may perform an action on a
+// resource, based on the subject's role in the organization owning it.
+package authz
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// Organization member roles, from most to least privileged.
+const (
+	RoleOwner  = "owner"
+	RoleAdmin  = "admin"
+	RoleMember = "member"
+	RoleViewer = "viewer"
+)
+
+type Resource string
+
+const (
+	ResourceAgent        Resource = "agent"
+	ResourceMeeting      Resource = "meeting"
+	ResourceChat         Resource = "chat"
+	ResourceRecording    Resource = "recording"
+	ResourceOrganization Resource = "organization"
+	ResourceMember       Resource = "member"
+	ResourceWebhook      Resource = "webhook"
+)
+
+type Action string
+
+const (
+	ActionRead   Action = "read"
+	ActionCreate Action = "create"
+	ActionUpdate Action = "update"
+	ActionDelete Action = "delete"
+)
+
+// ErrForbidden is returned, wrapped, when a subject is not allowed to act.
+var ErrForbidden = errors.New("forbidden")
+
+// Subject is a user acting within one organization. Role is empty when the
+// user is not a member of it.
+type Subject struct {
+	UserID string
+	OrgID  uuid.UUID
+	Role   string
+}
+
+var (
+	readOnly     = []Action{ActionRead}
+	readCreate   = []Action{ActionRead, ActionCreate}
+	updateDelete = []Action{ActionUpdate, ActionDelete}
+	all          = []Action{ActionRead, ActionCreate, ActionUpdate, ActionDelete}
+)
+
+// policy lists the actions each role may take on each resource. Anything not
+// listed is denied.
+var policy = map[string]map[Resource][]Action{
+	RoleViewer: {
+		ResourceAgent:        readOnly,
+		ResourceMeeting:      readOnly,
+		ResourceChat:         readCreate,
+		ResourceRecording:    readOnly,
+		ResourceOrganization: readOnly,
+		ResourceMember:       readOnly,
+	},
+	RoleMember: {
+		ResourceAgent:        readCreate,
+		ResourceMeeting:      readCreate,
+		ResourceChat:         readCreate,
+		ResourceRecording:    readOnly,
+		ResourceOrganization: readOnly,
+		ResourceMember:       readOnly,
+	},
+	RoleAdmin: {
+		ResourceAgent:        all,
+		ResourceMeeting:      all,
+		ResourceChat:         readCreate,
+		ResourceRecording:    readOnly,
+		ResourceOrganization: {ActionRead, ActionUpdate},
+		ResourceMember:       all,
+		ResourceWebhook:      all,
+	},
+	RoleOwner: {
+		ResourceAgent:        all,
+		ResourceMeeting:      all,
+		ResourceChat:         readCreate,
+		ResourceRecording:    readOnly,
+		ResourceOrganization: {ActionRead, ActionUpdate, ActionDelete},
+		ResourceMember:       all,
+		ResourceWebhook:      all,
+	},
+}
+
+// ownPolicy lists the extra actions each role may take on resources the
+// subject created.
+var ownPolicy = map[string]map[Resource][]Action{
+	RoleMember: {
+		ResourceAgent:   updateDelete,
+		ResourceMeeting: updateDelete,
+	},
+}
+
+// Allowed reports whether the subject may take action on resource anywhere
+// in the organization.
+func Allowed(subject Subject, resource Resource, action Action) bool {
+	if subject.UserID == "" || subject.OrgID == uuid.Nil {
+		return false
+	}
+	return slices.Contains(policy[subject.Role][resource], action)
+}
+
+// AllowedOn reports whether the subject may take action on one resource
+// created by ownerID.
+func AllowedOn(subject Subject, resource Resource, action Action, ownerID string) bool {
+	if Allowed(subject, resource, action) {
+		return true
+	}
+	if subject.UserID == "" || subject.OrgID == uuid.Nil || ownerID != subject.UserID {
+		return false
+	}
+	return slices.Contains(ownPolicy[subject.Role][resource], action)
+}
+
+// Authorize returns an error wrapping ErrForbidden unless the subject may
+// take action on resource.
+func Authorize(subject Subject, resource Resource, action Action) error {
+	if !Allowed(subject, resource, action) {
+		if subject.Role == "" {
+			return fmt.Errorf("%w: not a member of this organization", ErrForbidden)
+		}
+		return fmt.Errorf("%w: %s cannot %s %s", ErrForbidden, subject.Role, action, resource)
+	}
+	return nil
+}
+
+// AuthorizeOn is Authorize for one resource created by ownerID.
+func AuthorizeOn(subject Subject, resource Resource, action Action, ownerID string) error {
+	if !AllowedOn(subject, resource, action, ownerID) {
+		if subject.Role == "" {
+			return fmt.Errorf("%w: not a member of this organization", ErrForbidden)
+		}
+		return fmt.Errorf("%w: %s cannot %s %s created by another user", ErrForbidden, subject.Role, action, resource)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package authz
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestAllowed(t *testing.T) {
+	orgID := uuid.New()
+	tests := []struct {
+		name     string
+		subject  Subject
+		resource Resource
+		action   Action
+		want     bool
+	}{
+		{"viewer reads meetings", Subject{"u1", orgID, RoleViewer}, ResourceMeeting, ActionRead, true},
+		{"viewer cannot create meetings", Subject{"u1", orgID, RoleViewer}, ResourceMeeting, ActionCreate, false},
+		{"viewer chats", Subject{"u1", orgID, RoleViewer}, ResourceChat, ActionCreate, true},
+		{"member creates agents", Subject{"u1", orgID, RoleMember}, ResourceAgent, ActionCreate, true},
+		{"member cannot update any agent", Subject{"u1", orgID, RoleMember}, ResourceAgent, ActionUpdate, false},
+		{"member cannot delete any meeting", Subject{"u1", orgID, RoleMember}, ResourceMeeting, ActionDelete, false},
+		{"member cannot manage webhooks", Subject{"u1", orgID, RoleMember}, ResourceWebhook, ActionRead, false},
+		{"admin updates any meeting", Subject{"u1", orgID, RoleAdmin}, ResourceMeeting, ActionUpdate, true},
+		{"admin cannot delete organization", Subject{"u1", orgID, RoleAdmin}, ResourceOrganization, ActionDelete, false},
+		{"owner deletes organization", Subject{"u1", orgID, RoleOwner}, ResourceOrganization, ActionDelete, true},
+		{"nobody updates recordings", Subject{"u1", orgID, RoleOwner}, ResourceRecording, ActionUpdate, false},
+		{"non-member", Subject{"u1", orgID, ""}, ResourceMeeting, ActionRead, false},
+		{"unknown role", Subject{"u1", orgID, "guest"}, ResourceMeeting, ActionRead, false},
+		{"missing user", Subject{"", orgID, RoleOwner}, ResourceMeeting, ActionRead, false},
+		{"missing organization", Subject{"u1", uuid.Nil, RoleOwner}, ResourceMeeting, ActionRead, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Allowed(tt.subject, tt.resource, tt.action); got != tt.want {
+				t.Errorf("Allowed() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAllowedOn(t *testing.T) {
+	orgID := uuid.New()
+	tests := []struct {
+		name     string
+		subject  Subject
+		resource Resource
+		action   Action
+		ownerID  string
+		want     bool
+	}{
+		{"member updates own agent", Subject{"u1", orgID, RoleMember}, ResourceAgent, ActionUpdate, "u1", true},
+		{"member deletes own meeting", Subject{"u1", orgID, RoleMember}, ResourceMeeting, ActionDelete, "u1", true},
+		{"member cannot update another member's agent", Subject{"u1", orgID, RoleMember}, ResourceAgent, ActionUpdate, "u2", false},
+		{"member cannot delete another member's meeting", Subject{"u1", orgID, RoleMember}, ResourceMeeting, ActionDelete, "u2", false},
+		{"member reads another member's meeting", Subject{"u1", orgID, RoleMember}, ResourceMeeting, ActionRead, "u2", true},
+		{"viewer cannot update own meeting", Subject{"u1", orgID, RoleViewer}, ResourceMeeting, ActionUpdate, "u1", false},
+		{"admin deletes another member's agent", Subject{"u1", orgID, RoleAdmin}, ResourceAgent, ActionDelete, "u2", true},
+		{"owner updates another member's meeting", Subject{"u1", orgID, RoleOwner}, ResourceMeeting, ActionUpdate, "u2", true},
+		{"non-member cannot update own meeting", Subject{"u1", orgID, ""}, ResourceMeeting, ActionUpdate, "u1", false},
+		{"empty owner never matches", Subject{"", orgID, RoleMember}, ResourceMeeting, ActionUpdate, "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AllowedOn(tt.subject, tt.resource, tt.action, tt.ownerID); got != tt.want {
+				t.Errorf("AllowedOn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAuthorize(t *testing.T) {
+	orgID := uuid.New()
+	tests := []struct {
+		name    string
+		subject Subject
+		wantErr string
+	}{
+		{"allowed", Subject{"u1", orgID, RoleAdmin}, ""},
+		{"wrong role", Subject{"u1", orgID, RoleViewer}, "forbidden: viewer cannot delete meeting"},
+		{"non-member", Subject{"u1", orgID, ""}, "forbidden: not a member of this organization"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := Authorize(tt.subject, ResourceMeeting, ActionDelete)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Authorize() = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, ErrForbidden) {
+				t.Fatalf("Authorize() = %v, want ErrForbidden", err)
+			}
+			if err.Error() != tt.wantErr {
+				t.Errorf("Authorize() = %q, want %q", err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAuthorizeOn(t *testing.T) {
+	orgID := uuid.New()
+	member := Subject{"u1", orgID, RoleMember}
+
+	if err := AuthorizeOn(member, ResourceAgent, ActionUpdate, "u1"); err != nil {
+		t.Errorf("AuthorizeOn(own agent) = %v, want nil", err)
+	}
+	err := AuthorizeOn(member, ResourceAgent, ActionUpdate, "u2")
+	if !errors.Is(err, ErrForbidden) {
+		t.Fatalf("AuthorizeOn(other agent) = %v, want ErrForbidden", err)
+	}
+	if want := "forbidden: member cannot update agent created by another user"; err.Error() != want {
+		t.Errorf("AuthorizeOn(other agent) = %q, want %q", err.Error(), want)
+	}
+}
@@ -2,7 +2,9 @@ package config
 
 import (
 	"os"
+	"slices"
 	"strconv"
+	"strings"
 )
 
 type DBConfig struct {
@@ -17,6 +19,8 @@ type DBConfig struct {
 type ServerConfig struct {
 	Port                int
 	GracefulShutdownSec int
+	// SessionDrainSec bounds how long shutdown waits for live meetings to stop
+	SessionDrainSec int
 }
 
 type AppConfig struct {
@@ -28,12 +32,26 @@ type AppConfig struct {
 	AWS      AWSConfig
 	Gemini   GeminiConfig
 	OpenAI   OpenAIConfig
+	Realtime RealtimeConfig
+	LLM      LLMConfig
 	LogLevel string
 	Env      string
 }
 
 type AuthConfig struct {
 	JwksURL string
+	// Issuer and Audience are checked against BetterAuth tokens when set
+	Issuer   string
+	Audience string
+	// TrustedIssuers are accepted alongside BetterAuth, e.g. an OIDC provider
+	TrustedIssuers []TrustedIssuer
+	JwksRefreshMin int
+}
+
+type TrustedIssuer struct {
+	Issuer   string
+	JwksURL  string
+	Audience string
 }
 
 type PolarConfig struct {
@@ -57,6 +75,43 @@ type GeminiConfig struct {
 	RealtimeModel string
 	ChatModel     string
 	APIKey        string
+
+	// Sliding-window context compression for realtime sessions. A zero
+	// trigger disables compression.
+	CompressionTriggerTokens int64
+	CompressionTargetTokens  int64
+	// SessionRolloverMin replaces a realtime session with a fresh one seeded
+	// with a summary of the meeting so far. Zero disables rollover.
+	SessionRolloverMin int
+}
+
+type RealtimeConfig struct {
+	Provider string
+}
+
+// LLMConfig picks the provider and model for each text generation task.
+type LLMConfig struct {
+	Summary        ModelConfig
+	Chat           ModelConfig
+	Sentiment      ModelConfig
+	RollingSummary ModelConfig // keeps live meeting context across realtime sessions
+	Document       ModelConfig // extracts the text of uploaded PDFs
+	Embedding      ModelConfig // embeds knowledge base documents and queries
+	OllamaHost     string
+}
+
+type ModelConfig struct {
+	Provider string // "openai", "gemini" or "ollama"
+	Model    string
+	// Alternatives may be requested in place of Model, e.g. when
+	// reprocessing a meeting.
+	Alternatives []string
+}
+
+// Allows reports whether name is the configured model or one of its
+// alternatives.
+func (c ModelConfig) Allows(name string) bool {
+	return name == c.Model || slices.Contains(c.Alternatives, name)
 }
 
 type OpenAIConfig struct {
@@ -82,9 +137,14 @@ func LoadConfig() (*AppConfig, error) {
 		Server: ServerConfig{
 			Port:                9000,
 			GracefulShutdownSec: 5,
+			SessionDrainSec:     30,
 		},
 		Auth: AuthConfig{
-			JwksURL: os.Getenv("JWKS_URL"),
+			JwksURL:        os.Getenv("JWKS_URL"),
+			Issuer:         os.Getenv("JWT_ISSUER"),
+			Audience:       os.Getenv("JWT_AUDIENCE"),
+			TrustedIssuers: parseTrustedIssuers(os.Getenv("JWT_TRUSTED_ISSUERS")),
+			JwksRefreshMin: getEnvInt("JWKS_REFRESH_MIN", 15),
 		},
 		Polar: PolarConfig{
 			AccessToken: os.Getenv("POLAR_ACCESS_TOKEN"),
@@ -104,13 +164,92 @@ func LoadConfig() (*AppConfig, error) {
 			RealtimeModel: os.Getenv("GEMINI_REALTIME_MODEL"),
 			ChatModel:     os.Getenv("GEMINI_CHAT_MODEL"),
 			APIKey:        os.Getenv("GEMINI_API_KEY"),
+
+			CompressionTriggerTokens: int64(getEnvInt("GEMINI_COMPRESSION_TRIGGER_TOKENS", 64000)),
+			CompressionTargetTokens:  int64(getEnvInt("GEMINI_COMPRESSION_TARGET_TOKENS", 32000)),
+			SessionRolloverMin:       getEnvInt("GEMINI_SESSION_ROLLOVER_MIN", 30),
 		},
 		OpenAI: OpenAIConfig{
 			APIKey:  os.Getenv("OPENAI_API_KEY"),
 			BaseURL: os.Getenv("OPENAI_BASE_URL"),
 		},
+		Realtime: RealtimeConfig{
+			Provider: os.Getenv("REALTIME_PROVIDER"),
+		},
+		LLM: LLMConfig{
+			Summary: ModelConfig{
+				Provider:     getEnv("LLM_SUMMARY_PROVIDER", "openai"),
+				Model:        getEnv("LLM_SUMMARY_MODEL", "z-ai/glm4.7"),
+				Alternatives: getEnvList("LLM_SUMMARY_ALLOWED_MODELS"),
+			},
+			Chat: ModelConfig{
+				Provider: getEnv("LLM_CHAT_PROVIDER", "openai"),
+				Model:    getEnv("LLM_CHAT_MODEL", "z-ai/glm4.7"),
+			},
+			Sentiment: ModelConfig{
+				Provider: getEnv("LLM_SENTIMENT_PROVIDER", "ollama"),
+				Model:    getEnv("LLM_SENTIMENT_MODEL", "llama3.2:3b"),
+			},
+			RollingSummary: ModelConfig{
+				Provider: getEnv("LLM_ROLLING_SUMMARY_PROVIDER", "gemini"),
+				Model:    getEnv("LLM_ROLLING_SUMMARY_MODEL", "gemini-2.0-flash-lite"),
+			},
+			Document: ModelConfig{
+				Provider: getEnv("LLM_DOCUMENT_PROVIDER", "gemini"),
+				Model:    getEnv("LLM_DOCUMENT_MODEL", "gemini-2.0-flash-lite"),
+			},
+			Embedding: ModelConfig{
+				Provider: getEnv("LLM_EMBEDDING_PROVIDER", "gemini"),
+				Model:    getEnv("LLM_EMBEDDING_MODEL", "gemini-embedding-001"),
+			},
+			OllamaHost: getEnv("OLLAMA_HOST", "http://localhost:11434"),
+		},
 		LogLevel: "info",
 		Env:      os.Getenv("APP_ENV"),
 	}
 	return config, nil
 }
+
+func getEnv(key string, fallback string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return fallback
+}
+
+// getEnvList reads a comma-separated list, skipping empty entries.
+func getEnvList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(os.Getenv(key), ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+func getEnvInt(key string, fallback int) int {
+	value, err := strconv.Atoi(os.Getenv(key))
+	if err != nil {
+		return fallback
+	}
+	return value
+}
+
+// parseTrustedIssuers reads a comma-separated list of
+// "issuer|jwks_url|audience" entries; the audience is optional.
+func parseTrustedIssuers(value string) []TrustedIssuer {
+	var issuers []TrustedIssuer
+	for _, entry := range strings.Split(value, ",") {
+		parts := strings.Split(strings.TrimSpace(entry), "|")
+		if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+			continue
+		}
+		issuer := TrustedIssuer{Issuer: parts[0], JwksURL: parts[1]}
+		if len(parts) > 2 {
+			issuer.Audience = parts[2]
+		}
+		issuers = append(issuers, issuer)
+	}
+	return issuers
+}
@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS meeting_participant (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    meeting_id UUID NOT NULL REFERENCES meeting(id) ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL REFERENCES "user"(id) ON DELETE CASCADE,
+    invited_by VARCHAR(255) NOT NULL,
+    status VARCHAR(255) NOT NULL DEFAULT 'invited', -- "invited" or "joined"
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT meeting_participant_meeting_user_unique UNIQUE (meeting_id, user_id)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS meeting_participant;
+-- +goose StatementEnd
@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS meeting_action_item (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    meeting_id UUID NOT NULL REFERENCES meeting(id) ON DELETE CASCADE,
+    description TEXT NOT NULL,
+    owner VARCHAR(255),
+    due_date TIMESTAMPTZ,
+    source_timestamp TIMESTAMPTZ, -- when in the meeting the item came up
+    source VARCHAR(255) NOT NULL DEFAULT 'agent', -- "agent" or "summary"
+    status VARCHAR(255) NOT NULL DEFAULT 'open', -- "open" or "done"
+    created_by VARCHAR(255),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS meeting_note (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    meeting_id UUID NOT NULL REFERENCES meeting(id) ON DELETE CASCADE,
+    kind VARCHAR(255) NOT NULL DEFAULT 'note', -- "note" or "reminder"
+    content TEXT NOT NULL,
+    author VARCHAR(255),
+    remind_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS meeting_action_item_meeting_id_idx ON meeting_action_item(meeting_id);
+CREATE INDEX IF NOT EXISTS meeting_note_meeting_id_idx ON meeting_note(meeting_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS meeting_note;
+DROP TABLE IF EXISTS meeting_action_item;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- NULL means the agent predates tool configuration and gets the default tools.
+ALTER TABLE agent ADD COLUMN IF NOT EXISTS tools JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE agent DROP COLUMN IF EXISTS tools;
+-- +goose StatementEnd
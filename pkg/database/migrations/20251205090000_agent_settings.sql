@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- NULL means the agent uses the server defaults for voice, language and model.
+ALTER TABLE agent ADD COLUMN IF NOT EXISTS settings JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE agent DROP COLUMN IF EXISTS settings;
+-- +goose StatementEnd
@@ -0,0 +1,34 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE EXTENSION IF NOT EXISTS vector;
+
+CREATE TABLE IF NOT EXISTS agent_document (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    agent_id UUID NOT NULL REFERENCES agent(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL, -- "text/plain", "text/markdown" or "application/pdf"
+    size_bytes BIGINT NOT NULL,
+    chunk_count INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS agent_document_chunk (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    document_id UUID NOT NULL REFERENCES agent_document(id) ON DELETE CASCADE,
+    agent_id UUID NOT NULL REFERENCES agent(id) ON DELETE CASCADE,
+    chunk_index INTEGER NOT NULL,
+    content TEXT NOT NULL,
+    embedding vector(768) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS agent_document_agent_id_idx ON agent_document(agent_id);
+CREATE INDEX IF NOT EXISTS agent_document_chunk_agent_id_idx ON agent_document_chunk(agent_id);
+CREATE INDEX IF NOT EXISTS agent_document_chunk_embedding_idx ON agent_document_chunk USING hnsw (embedding vector_cosine_ops);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS agent_document_chunk;
+DROP TABLE IF EXISTS agent_document;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS agent_version (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    agent_id UUID NOT NULL REFERENCES agent(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    instructions TEXT NOT NULL,
+    tools JSONB,
+    settings JSONB,
+    rolled_back_from INTEGER, -- version restored by a rollback
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (agent_id, version)
+);
+
+-- Existing agents start at version 1 with their current configuration.
+INSERT INTO agent_version (agent_id, version, name, instructions, tools, settings, created_at)
+SELECT id, 1, name, instructions, tools, settings, updated_at FROM agent;
+
+ALTER TABLE meeting ADD COLUMN IF NOT EXISTS agent_version_id UUID REFERENCES agent_version(id) ON DELETE SET NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE meeting DROP COLUMN IF EXISTS agent_version_id;
+DROP TABLE IF EXISTS agent_version;
+-- +goose StatementEnd
@@ -0,0 +1,59 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS organization (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    name VARCHAR(255) NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+-- user_id has no foreign key: agents and meetings may belong to users the
+-- auth provider has not synced into "user" yet.
+CREATE TABLE IF NOT EXISTS organization_member (
+    org_id UUID NOT NULL REFERENCES organization(id) ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL,
+    role VARCHAR(255) NOT NULL DEFAULT 'member', -- "owner", "admin", "member" or "viewer"
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (org_id, user_id),
+    CONSTRAINT organization_member_role_check CHECK (role IN ('owner', 'admin', 'member', 'viewer'))
+);
+
+CREATE INDEX IF NOT EXISTS organization_member_user_id_idx ON organization_member(user_id);
+
+-- Every existing user gets a personal organization owning their agents and meetings.
+INSERT INTO organization (name, created_by)
+SELECT COALESCE(u.name || '''s workspace', 'Personal workspace'), ids.user_id
+FROM (
+    SELECT user_id FROM agent
+    UNION
+    SELECT user_id FROM meeting
+    UNION
+    SELECT id FROM "user"
+) AS ids
+LEFT JOIN "user" AS u ON u.id = ids.user_id;
+
+INSERT INTO organization_member (org_id, user_id, role)
+SELECT id, created_by, 'owner' FROM organization;
+
+ALTER TABLE agent ADD COLUMN IF NOT EXISTS org_id UUID REFERENCES organization(id) ON DELETE CASCADE;
+ALTER TABLE meeting ADD COLUMN IF NOT EXISTS org_id UUID REFERENCES organization(id) ON DELETE CASCADE;
+
+UPDATE agent SET org_id = o.id FROM organization AS o WHERE o.created_by = agent.user_id;
+UPDATE meeting SET org_id = o.id FROM organization AS o WHERE o.created_by = meeting.user_id;
+
+ALTER TABLE agent ALTER COLUMN org_id SET NOT NULL;
+ALTER TABLE meeting ALTER COLUMN org_id SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS agent_org_id_idx ON agent(org_id);
+CREATE INDEX IF NOT EXISTS meeting_org_id_idx ON meeting(org_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE meeting DROP COLUMN IF EXISTS org_id;
+ALTER TABLE agent DROP COLUMN IF EXISTS org_id;
+DROP TABLE IF EXISTS organization_member;
+DROP TABLE IF EXISTS organization;
+-- +goose StatementEnd
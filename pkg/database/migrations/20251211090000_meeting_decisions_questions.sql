@@ -0,0 +1,29 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS meeting_decision (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    meeting_id UUID NOT NULL REFERENCES meeting(id) ON DELETE CASCADE,
+    description TEXT NOT NULL,
+    made_by VARCHAR(255),
+    source_timestamp TIMESTAMPTZ, -- when in the meeting the decision was made
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE TABLE IF NOT EXISTS meeting_question (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    meeting_id UUID NOT NULL REFERENCES meeting(id) ON DELETE CASCADE,
+    question TEXT NOT NULL,
+    asked_by VARCHAR(255),
+    source_timestamp TIMESTAMPTZ, -- when in the meeting the question came up
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS meeting_decision_meeting_id_idx ON meeting_decision(meeting_id);
+CREATE INDEX IF NOT EXISTS meeting_question_meeting_id_idx ON meeting_question(meeting_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS meeting_question;
+DROP TABLE IF EXISTS meeting_decision;
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS meeting_summary (
+    id UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    meeting_id UUID NOT NULL REFERENCES meeting(id) ON DELETE CASCADE,
+    version INTEGER NOT NULL,
+    content TEXT NOT NULL,
+    template VARCHAR(255), -- summary template ID, NULL for summaries written before history was kept
+    model VARCHAR(255),
+    active BOOLEAN NOT NULL DEFAULT FALSE, -- the one copied onto meeting.summary
+    created_by VARCHAR(255), -- user who requested a reprocess, NULL for the automatic run
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE (meeting_id, version)
+);
+
+INSERT INTO meeting_summary (meeting_id, version, content, active)
+SELECT id, 1, summary, TRUE FROM meeting WHERE summary IS NOT NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS meeting_summary;
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS meeting_processing (
+    meeting_id UUID PRIMARY KEY REFERENCES meeting(id) ON DELETE CASCADE,
+    status VARCHAR(255) NOT NULL DEFAULT 'queued', -- "queued", "running", "succeeded" or "failed"
+    step VARCHAR(255), -- the post-processing step running or last run
+    error TEXT,
+    attempts INTEGER NOT NULL DEFAULT 0, -- attempts of the current step
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS meeting_processing;
+-- +goose StatementEnd
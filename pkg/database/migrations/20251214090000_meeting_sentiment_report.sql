@@ -0,0 +1,18 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS meeting_sentiment_report (
+    meeting_id UUID PRIMARY KEY REFERENCES meeting(id) ON DELETE CASCADE,
+    overall VARCHAR(255) NOT NULL, -- "positive", "negative", "neutral" or "mixed"
+    score DOUBLE PRECISION NOT NULL, -- from -1 (negative) to 1 (positive)
+    summary TEXT NOT NULL,
+    participants JSONB NOT NULL, -- per-speaker sentiment, see inngest.ParticipantSentiment
+    model VARCHAR(255),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS meeting_sentiment_report;
+-- +goose StatementEnd
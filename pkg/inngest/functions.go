@@ -3,8 +3,10 @@ package inngest
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"slices"
 	"strings"
 	"time"
 
@@ -13,17 +15,21 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/google/uuid"
 	"github.com/inngest/inngestgo"
-	"github.com/inngest/inngestgo/step"
 	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
-	"google.golang.org/genai"
-
-	"github.com/openai/openai-go"
-	"github.com/openai/openai-go/option"
+	"github.com/rahulSailesh-shah/converSense/pkg/agentconfig"
 )
 
 func (i *Inngest) RegisterFunctions() error {
-	err := i.postProcessMeeting()
-	return err
+	if err := i.postProcessMeeting(); err != nil {
+		return err
+	}
+	if err := i.postProcessFailed(); err != nil {
+		return err
+	}
+	if err := i.deliverReminder(); err != nil {
+		return err
+	}
+	return i.deliverWebhook()
 }
 
 type SessionTranscript struct {
@@ -31,30 +37,88 @@ type SessionTranscript struct {
 }
 
 type SessionTranscriptSegment struct {
-	Role      string    `json:"role"`      // "user" or "ai"
-	Name      string    `json:"name"`      // Speaker's name
-	Content   string    `json:"content"`   // Transcript text
-	Timestamp time.Time `json:"timestamp"` // When the segment was captured
+	Role      string    `json:"role"`               // "user" or "ai"
+	Identity  string    `json:"identity,omitempty"` // LiveKit participant identity of the speaker
+	Name      string    `json:"name"`               // Speaker's name
+	Content   string    `json:"content"`            // Transcript text
+	Timestamp time.Time `json:"timestamp"`          // When the segment was captured
+}
+
+// Post-processing steps that can be re-run on demand.
+const (
+	StepSummary     = "summary"
+	StepActionItems = "action_items"
+	StepSentiment   = "sentiment"
+)
+
+// postProcessEventVersion identifies the shape of the post-process event
+// data. Events sent before options existed carry no version and run every
+// step.
+const postProcessEventVersion = "2025-12-12.01"
+
+// PostProcessOptions narrows a post-processing run. The zero value runs every
+// step with the agent's summary template and the configured summary model.
+type PostProcessOptions struct {
+	Steps       []string `json:"steps,omitempty"`
+	Template    string   `json:"template,omitempty"`
+	Model       string   `json:"model,omitempty"`
+	RequestedBy string   `json:"requestedBy,omitempty"`
+}
+
+func (o PostProcessOptions) runs(step string) bool {
+	return len(o.Steps) == 0 || slices.Contains(o.Steps, step)
 }
 
-func (i *Inngest) PostProcessMeeting(ctx context.Context, meetingId string, userId string) error {
+// ErrProcessingInProgress is returned when a meeting is queued for
+// post-processing while a run is already queued or running. A run whose
+// status has not moved for 30 minutes is treated as lost (a crashed worker
+// or an event that never arrived) and may be queued again.
+var ErrProcessingInProgress = errors.New("meeting is already being processed")
+
+func (i *Inngest) PostProcessMeeting(ctx context.Context, meetingId string) error {
+	return i.ReprocessMeeting(ctx, meetingId, PostProcessOptions{})
+}
+
+// ReprocessMeeting runs post-processing again for a completed meeting.
+func (i *Inngest) ReprocessMeeting(ctx context.Context, meetingId string, options PostProcessOptions) error {
+	meetingID, err := uuid.Parse(meetingId)
+	if err != nil {
+		return err
+	}
+	queued, err := i.queries.QueueMeetingProcessing(ctx, meetingID)
+	if err != nil {
+		return fmt.Errorf("failed to queue post-processing: %w", err)
+	}
+	if queued == 0 {
+		return ErrProcessingInProgress
+	}
+
 	fmt.Println("[--] Meeting post-processing event sent", "meetingID", meetingId)
-	_, err := i.client.Send(ctx, inngestgo.Event{
+	_, err = i.client.Send(ctx, inngestgo.Event{
 		Name: "conversense/post-process-meeting",
 		Data: map[string]any{
 			"meetingId": meetingId,
-			"userId":    userId,
+			"options":   options,
 		},
+		Version: postProcessEventVersion,
 	})
+	if err != nil {
+		message := err.Error()
+		// Record the failure even if the request was cancelled, otherwise the
+		// row stays queued and blocks a retry.
+		if finishErr := i.finishProcessing(context.WithoutCancel(ctx), meetingID, ProcessingFailed, &message); finishErr != nil {
+			fmt.Printf("[ERROR] Failed to record processing failure for meeting %s: %v\n", meetingId, finishErr)
+		}
+	}
 	return err
 }
 
-func (i *Inngest) postProcessMeeting() error {
-	type PostProcessEventData struct {
-		MeetingId string `json:"meetingId"`
-		UserID    string `json:"userId"`
-	}
+type postProcessEventData struct {
+	MeetingId string             `json:"meetingId"`
+	Options   PostProcessOptions `json:"options"`
+}
 
+func (i *Inngest) postProcessMeeting() error {
 	_, err := inngestgo.CreateFunction(
 		i.client,
 		inngestgo.FunctionOpts{
@@ -62,19 +126,17 @@ func (i *Inngest) postProcessMeeting() error {
 			Name: "Post Process Meeting",
 		},
 		inngestgo.EventTrigger("conversense/post-process-meeting", nil),
-		func(ctx context.Context, input inngestgo.Input[PostProcessEventData]) (any, error) {
+		func(ctx context.Context, input inngestgo.Input[postProcessEventData]) (any, error) {
 			fmt.Println("[---] Meeting post-processing started", "meetingID", input.Event.Data.MeetingId)
 			// Fetch meeting details
 			meetingId, err := uuid.Parse(input.Event.Data.MeetingId)
 			if err != nil {
 				return nil, err
 			}
-			userId := input.Event.Data.UserID
-			meetingDetails, err := step.Run(ctx, "fetch-data", func(ctx context.Context) (*repo.GetMeetingRow, error) {
-				meetingDetails, err := i.queries.GetMeeting(ctx, repo.GetMeetingParams{
-					ID:     meetingId,
-					UserID: userId,
-				})
+			options := input.Event.Data.Options
+			run := &processingRun{inngest: i, meetingID: meetingId, attempt: input.InputCtx.Attempt}
+			meetingDetails, err := runStep(ctx, run, "fetch-data", func(ctx context.Context) (*repo.GetMeetingDetailsRow, error) {
+				meetingDetails, err := i.queries.GetMeetingDetails(ctx, meetingId)
 				return &meetingDetails, err
 			})
 			if err != nil {
@@ -86,7 +148,7 @@ func (i *Inngest) postProcessMeeting() error {
 			if transcriptURL == nil {
 				return "", fmt.Errorf("no transcript URL found for meeting")
 			}
-			transcriptData, err := step.Run(ctx, "fetch-transcript",
+			transcriptData, err := runStep(ctx, run, "fetch-transcript",
 				func(ctx context.Context) (*SessionTranscript, error) {
 					transcript, err := i.fetchTranscriptFromS3(ctx, *transcriptURL)
 					return transcript, err
@@ -96,36 +158,93 @@ func (i *Inngest) postProcessMeeting() error {
 			}
 			fmt.Println("[---] Transcript fetched successfully", "meetingID", meetingId)
 
-			// Generate summary
-			summary, err := step.Run(ctx, "generate-summary", func(ctx context.Context) (string, error) {
-				summary, err := i.processTranscriptWithOpenAI(ctx, transcriptData)
-				return summary, err
-			})
-			if err != nil {
-				return nil, err
+			var summary string
+			if meetingDetails.Summary != nil {
+				summary = *meetingDetails.Summary
+			}
+			if options.runs(StepSummary) {
+				template, err := runStep(ctx, run, "resolve-summary-template", func(ctx context.Context) (agentconfig.SummaryTemplate, error) {
+					return i.summaryTemplateFor(ctx, meetingDetails, options.Template)
+				})
+				if err != nil {
+					return nil, err
+				}
+				model := i.summaryModel
+				if options.Model != "" {
+					model = model.WithName(options.Model)
+				}
+
+				// Generate summary. Long transcripts are summarized chunk by chunk,
+				// each in its own step so a failed chunk retries alone.
+				chunks := chunkTranscript(transcriptData, chunkTokenBudget, chunkMaxDuration)
+				if len(chunks) <= 1 {
+					summary, err = runStep(ctx, run, "generate-summary", func(ctx context.Context) (string, error) {
+						return i.summarizeTranscript(ctx, model, transcriptData, template)
+					})
+				} else {
+					summary, err = i.summarizeChunks(ctx, run, model, chunks, template)
+				}
+				if err != nil {
+					return nil, err
+				}
+				fmt.Println("[---] Summary generated successfully", "meetingID", meetingId)
+				fmt.Println(summary)
+
+				_, err = runStep(ctx, run, "save-summary", func(ctx context.Context) (any, error) {
+					return nil, i.saveSummary(ctx, meetingDetails, summary, template, model, options.RequestedBy)
+				})
+				if err != nil {
+					return nil, err
+				}
+				fmt.Println("[---] Summary saved to database successfully", "meetingID", meetingId)
 			}
-			fmt.Println("[---] Summary generated successfully", "meetingID", meetingId)
-			fmt.Println(summary)
 
-			_, err = step.Run(ctx, "save-summary", func(ctx context.Context) (any, error) {
-				_, err := i.queries.UpdateMeeting(ctx, repo.UpdateMeetingParams{
-					ID:            meetingDetails.ID,
-					UserID:        meetingDetails.UserID,
-					Name:          meetingDetails.Name,
-					AgentID:       meetingDetails.AgentID,
-					Status:        "completed",
-					Summary:       &summary,
-					StartTime:     meetingDetails.StartTime,
-					EndTime:       meetingDetails.EndTime,
-					TranscriptUrl: meetingDetails.TranscriptUrl,
-					RecordingUrl:  meetingDetails.RecordingUrl,
+			if options.runs(StepActionItems) {
+				// Structured follow-ups are best effort: the summary is already
+				// saved, so a failure here is logged rather than failing the run.
+				insights, err := runStep(ctx, run, "extract-insights", func(ctx context.Context) (*MeetingInsights, error) {
+					return i.extractInsights(ctx, transcriptData)
 				})
-				return nil, err
+				if err == nil {
+					_, err = runStep(ctx, run, "save-insights", func(ctx context.Context) (any, error) {
+						return nil, i.saveInsights(ctx, meetingDetails.ID, transcriptData, insights)
+					})
+				}
+				if err != nil {
+					fmt.Printf("[ERROR] Failed to extract insights for meeting %s: %v\n", meetingId, err)
+				}
+			}
+
+			if options.runs(StepSentiment) {
+				// The sentiment report is best effort as well.
+				report, err := runStep(ctx, run, "extract-sentiment", func(ctx context.Context) (*SentimentReport, error) {
+					return i.extractSentiment(ctx, transcriptData)
+				})
+				if err == nil {
+					_, err = runStep(ctx, run, "save-sentiment", func(ctx context.Context) (any, error) {
+						return nil, i.saveSentiment(ctx, meetingDetails.ID, report)
+					})
+				}
+				if err != nil {
+					fmt.Printf("[ERROR] Failed to build sentiment report for meeting %s: %v\n", meetingId, err)
+				}
+			}
+
+			if options.runs(StepSummary) || options.runs(StepActionItems) {
+				_, err = runStep(ctx, run, "publish-webhooks", func(ctx context.Context) (any, error) {
+					return nil, i.publishSummaryWebhooks(ctx, meetingDetails, summary, options)
+				})
+				if err != nil {
+					fmt.Printf("[ERROR] Failed to publish webhooks for meeting %s: %v\n", meetingId, err)
+				}
+			}
+
+			_, err = runStep(ctx, run, "mark-succeeded", func(ctx context.Context) (any, error) {
+				return nil, i.finishProcessing(ctx, meetingId, ProcessingSucceeded, nil)
 			})
 			if err != nil {
 				return nil, err
 			}
-			fmt.Println("[---] Summary saved to database successfully", "meetingID", meetingId)
 
 			return summary, nil
 		},
@@ -178,105 +297,3 @@ func (i *Inngest) fetchTranscriptFromS3(ctx context.Context, transcriptURL strin
 
 	return &transcript, nil
 }
-
-func (i *Inngest) processTranscriptWithGemini(ctx context.Context, transcript *SessionTranscript,
-) (string, error) {
-	client, err := genai.NewClient(ctx, &genai.ClientConfig{
-		APIKey:  i.geminiConfig.APIKey,
-		Backend: genai.BackendGeminiAPI,
-	})
-	if err != nil {
-		return "", fmt.Errorf("failed to create Gemini client: %w", err)
-	}
-
-	var fullText strings.Builder
-	for _, segment := range transcript.Segments {
-		fullText.WriteString(fmt.Sprintf("[%s]: %s\n", segment.Name, segment.Content))
-	}
-
-	// Create prompt
-	prompt := fmt.Sprintf(`
-        You are an expert summarizer. You write readable, concise, simple content. You are given a transcript of a meeting and you need to summarize it.
-
-        Use the following markdown structure for every output:
-
-        ### Overview
-        Provide a detailed, engaging summary of the session's content. Focus on major features, user workflows, and any key takeaways. Write in a narrative style, using full sentences. Highlight unique or powerful aspects of the product, platform, or discussion.
-
-        ### Notes
-        Break down key content into thematic sections with timestamp ranges. Each section should summarize key points, actions, or demos in bullet format.
-
-        Example:
-        #### Section Name
-        - Main point or demo shown here
-        - Another key insight or interaction
-        - Follow-up tool or explanation provided
-
-        #### Next Section
-        - Feature X automatically does Y
-        - Mention of integration with Z
-
-        Transcript:\n
-        %s`, fullText.String())
-
-	model := "gemini-2.0-flash-lite"
-	response, err := client.Models.GenerateContent(
-		ctx,
-		model,
-		genai.Text(prompt),
-		nil,
-	)
-	if err != nil {
-		return "", fmt.Errorf("failed to generate content: %w", err)
-	}
-
-	return response.Text(), nil
-}
-
-func (i *Inngest) processTranscriptWithOpenAI(ctx context.Context, transcript *SessionTranscript) (string, error) {
-	client := openai.NewClient(
-		option.WithAPIKey(i.openaiConfig.APIKey),
-		option.WithBaseURL(i.openaiConfig.BaseURL),
-	)
-
-	var fullText strings.Builder
-	for _, segment := range transcript.Segments {
-		fullText.WriteString(fmt.Sprintf("[%s]: %s\n", segment.Name, segment.Content))
-	}
-
-	prompt := fmt.Sprintf(`
-        You are an expert summarizer. You write readable, concise, simple content. You are given a transcript of a meeting and you need to summarize it.
-
-        Use the following markdown structure for every output:
-
-        ### Overview
-        Provide a detailed, engaging summary of the session's content. Focus on major features, user workflows, and any key takeaways. Write in a narrative style, using full sentences. Highlight unique or powerful aspects of the product, platform, or discussion.
-
-        ### Notes
-        Break down key content into thematic sections with timestamp ranges. Each section should summarize key points, actions, or demos in bullet format.
-
-        Example:
-        #### Section Name
-        - Main point or demo shown here
-        - Another key insight or interaction
-        - Follow-up tool or explanation provided
-
-        #### Next Section
-        - Feature X automatically does Y
-        - Mention of integration with Z
-
-        Transcript:\n
-        %s`, fullText.String())
-
-	response, err := client.Chat.Completions.New(ctx, openai.ChatCompletionNewParams{
-		Model: "z-ai/glm4.7",
-		Messages: []openai.ChatCompletionMessageParamUnion{
-			openai.UserMessage(prompt),
-		},
-	})
-	if err != nil {
-		return "", fmt.Errorf("failed to generate content: %w", err)
-	}
-
-	return response.Choices[0].Message.Content, nil
-}
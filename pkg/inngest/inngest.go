@@ -3,21 +3,23 @@ package inngest
 import (
 	"github.com/gin-gonic/gin"
 	"github.com/inngest/inngestgo"
+	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
 	"github.com/rahulSailesh-shah/converSense/pkg/config"
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
 )
 
 type Inngest struct {
 	client       inngestgo.Client
 	awsConfig    *config.AWSConfig
-	geminiConfig *config.GeminiConfig
-	openaiConfig *config.OpenAIConfig
+	summaryModel *llm.Model
+	db           *pgxpool.Pool
 	queries      *repo.Queries
 }
 
 func NewInngest(awsConfig *config.AWSConfig,
-	geminiConfig *config.GeminiConfig,
-	openaiConfig *config.OpenAIConfig,
+	summaryModel *llm.Model,
+	db *pgxpool.Pool,
 	queries *repo.Queries,
 ) (*Inngest, error) {
 	client, err := inngestgo.NewClient(inngestgo.ClientOpts{
@@ -31,8 +33,8 @@ func NewInngest(awsConfig *config.AWSConfig,
 	i := &Inngest{
 		client:       client,
 		awsConfig:    awsConfig,
-		geminiConfig: geminiConfig,
-		openaiConfig: openaiConfig,
+		summaryModel: summaryModel,
+		db:           db,
 		queries:      queries,
 	}
 
@@ -0,0 +1,226 @@
+package inngest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
+)
+
+// MeetingInsights is the structured follow-up extracted from a transcript.
+// Timestamps use the transcript's "HH:MM:SS" format and due dates
+// "YYYY-MM-DD".
+type MeetingInsights struct {
+	ActionItems   []InsightActionItem `json:"actionItems"`
+	Decisions     []InsightDecision   `json:"decisions"`
+	OpenQuestions []InsightQuestion   `json:"openQuestions"`
+}
+
+type InsightActionItem struct {
+	Description string  `json:"description"`
+	Owner       *string `json:"owner"`
+	DueDate     *string `json:"dueDate"`
+	Timestamp   *string `json:"timestamp"`
+}
+
+type InsightDecision struct {
+	Description string  `json:"description"`
+	MadeBy      *string `json:"madeBy"`
+	Timestamp   *string `json:"timestamp"`
+}
+
+type InsightQuestion struct {
+	Question  string  `json:"question"`
+	AskedBy   *string `json:"askedBy"`
+	Timestamp *string `json:"timestamp"`
+}
+
+const insightsPrompt = `
+        You extract structured follow-ups from a meeting transcript.
+        Each transcript line is prefixed with its timestamp and the name of the person who spoke.
+
+        Respond with a single JSON object and nothing else, using exactly this schema:
+        {
+          "actionItems": [{"description": string, "owner": string|null, "dueDate": "YYYY-MM-DD"|null, "timestamp": "HH:MM:SS"|null}],
+          "decisions": [{"description": string, "madeBy": string|null, "timestamp": "HH:MM:SS"|null}],
+          "openQuestions": [{"question": string, "askedBy": string|null, "timestamp": "HH:MM:SS"|null}]
+        }
+
+        - actionItems are concrete commitments to do something after the meeting. The owner is the person who will do it.
+        - decisions are conclusions the participants agreed on.
+        - openQuestions are questions raised but not answered by the end of the meeting.
+        - timestamp is the transcript timestamp of the line where the item came up.
+        - Only set dueDate when a date or deadline was stated. Use empty arrays when there is nothing to report.
+
+        Transcript:\n
+        %s`
+
+// extractInsights asks the summary model for the structured follow-ups. The
+// answer is decoded strictly and validated, so a malformed one fails the step
+// and gets retried.
+func (i *Inngest) extractInsights(ctx context.Context, transcript *SessionTranscript) (*MeetingInsights, error) {
+	var insights MeetingInsights
+	err := i.summaryModel.CompleteJSON(ctx, &insights, llm.User(fmt.Sprintf(insightsPrompt, formatTranscript(transcript))))
+	if err != nil {
+		return nil, err
+	}
+	if err := insights.Validate(); err != nil {
+		return nil, err
+	}
+	return &insights, nil
+}
+
+// Validate checks the insights against the schema the model was given.
+func (m *MeetingInsights) Validate() error {
+	for idx, item := range m.ActionItems {
+		if strings.TrimSpace(item.Description) == "" {
+			return fmt.Errorf("action item %d has no description", idx)
+		}
+		if item.DueDate != nil {
+			if _, err := time.Parse(time.DateOnly, *item.DueDate); err != nil {
+				return fmt.Errorf("action item %d has an invalid due date %q", idx, *item.DueDate)
+			}
+		}
+		if err := validateTimestamp(item.Timestamp); err != nil {
+			return fmt.Errorf("action item %d: %w", idx, err)
+		}
+	}
+	for idx, decision := range m.Decisions {
+		if strings.TrimSpace(decision.Description) == "" {
+			return fmt.Errorf("decision %d has no description", idx)
+		}
+		if err := validateTimestamp(decision.Timestamp); err != nil {
+			return fmt.Errorf("decision %d: %w", idx, err)
+		}
+	}
+	for idx, question := range m.OpenQuestions {
+		if strings.TrimSpace(question.Question) == "" {
+			return fmt.Errorf("open question %d is empty", idx)
+		}
+		if err := validateTimestamp(question.Timestamp); err != nil {
+			return fmt.Errorf("open question %d: %w", idx, err)
+		}
+	}
+	return nil
+}
+
+func validateTimestamp(timestamp *string) error {
+	if timestamp == nil {
+		return nil
+	}
+	if _, err := time.Parse(time.TimeOnly, *timestamp); err != nil {
+		return fmt.Errorf("invalid timestamp %q", *timestamp)
+	}
+	return nil
+}
+
+// saveInsights replaces the meeting's previously extracted insights in one
+// transaction, so a retried step does not store them twice. Action items the
+// agent created during the meeting are kept.
+func (i *Inngest) saveInsights(ctx context.Context, meetingID uuid.UUID, transcript *SessionTranscript, insights *MeetingInsights) error {
+	tx, err := i.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := i.queries.WithTx(tx)
+
+	// Action items a user already worked on are kept as they are; only
+	// untouched ones are replaced, and re-extracting a kept item is skipped.
+	err = qtx.DeleteOpenMeetingActionItemsBySource(ctx, repo.DeleteOpenMeetingActionItemsBySourceParams{
+		MeetingID: meetingID,
+		Source:    "summary",
+	})
+	if err != nil {
+		return err
+	}
+	kept, err := qtx.GetMeetingActionItems(ctx, meetingID)
+	if err != nil {
+		return err
+	}
+	keptItems := make(map[string]bool, len(kept))
+	for _, item := range kept {
+		if item.Source == "summary" {
+			keptItems[actionItemKey(item.Description)] = true
+		}
+	}
+	if err := qtx.DeleteMeetingDecisions(ctx, meetingID); err != nil {
+		return err
+	}
+	if err := qtx.DeleteMeetingQuestions(ctx, meetingID); err != nil {
+		return err
+	}
+
+	for _, item := range insights.ActionItems {
+		if keptItems[actionItemKey(item.Description)] {
+			continue
+		}
+		var dueDate *time.Time
+		if item.DueDate != nil {
+			parsed, _ := time.Parse(time.DateOnly, *item.DueDate)
+			dueDate = &parsed
+		}
+		_, err := qtx.CreateMeetingActionItem(ctx, repo.CreateMeetingActionItemParams{
+			MeetingID:       meetingID,
+			Description:     item.Description,
+			Owner:           item.Owner,
+			DueDate:         dueDate,
+			SourceTimestamp: transcriptTime(transcript, item.Timestamp),
+			Source:          "summary",
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, decision := range insights.Decisions {
+		_, err := qtx.CreateMeetingDecision(ctx, repo.CreateMeetingDecisionParams{
+			MeetingID:       meetingID,
+			Description:     decision.Description,
+			MadeBy:          decision.MadeBy,
+			SourceTimestamp: transcriptTime(transcript, decision.Timestamp),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	for _, question := range insights.OpenQuestions {
+		_, err := qtx.CreateMeetingQuestion(ctx, repo.CreateMeetingQuestionParams{
+			MeetingID:       meetingID,
+			Question:        question.Question,
+			AskedBy:         question.AskedBy,
+			SourceTimestamp: transcriptTime(transcript, question.Timestamp),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return tx.Commit(ctx)
+}
+
+// actionItemKey identifies an action item across extractions.
+func actionItemKey(description string) string {
+	return strings.ToLower(strings.TrimSpace(description))
+}
+
+// transcriptTime turns an "HH:MM:SS" transcript timestamp back into a full
+// time on the day the meeting started, allowing meetings that cross midnight.
+func transcriptTime(transcript *SessionTranscript, timestamp *string) *time.Time {
+	if timestamp == nil || len(transcript.Segments) == 0 {
+		return nil
+	}
+	clock, err := time.Parse(time.TimeOnly, *timestamp)
+	if err != nil {
+		return nil
+	}
+	start := transcript.Segments[0].Timestamp
+	t := time.Date(start.Year(), start.Month(), start.Day(),
+		clock.Hour(), clock.Minute(), clock.Second(), 0, start.Location())
+	if t.Before(start.Truncate(time.Second)) {
+		t = t.AddDate(0, 0, 1)
+	}
+	return &t
+}
@@ -0,0 +1,93 @@
+package inngest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngestgo"
+	"github.com/inngest/inngestgo/step"
+	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+)
+
+// Post-processing statuses recorded on a meeting.
+const (
+	ProcessingQueued    = "queued"
+	ProcessingRunning   = "running"
+	ProcessingSucceeded = "succeeded"
+	ProcessingFailed    = "failed"
+)
+
+// postProcessFunctionID is the ID Inngest gives the post-process function,
+// prefixed with the app ID, as reported in its failure events.
+const postProcessFunctionID = "core-post-process-meeting"
+
+// processingRun records the progress of one post-processing run on its
+// meeting.
+type processingRun struct {
+	inngest   *Inngest
+	meetingID uuid.UUID
+	// attempt is the zero-based attempt of the step executing in this request
+	attempt int
+}
+
+// runStep runs a post-processing step, first recording it as the meeting's
+// current step. Tracking is best effort and never fails the step.
+func runStep[T any](ctx context.Context, run *processingRun, id string, fn func(ctx context.Context) (T, error)) (T, error) {
+	return step.Run(ctx, id, func(ctx context.Context) (T, error) {
+		err := run.inngest.queries.StartMeetingProcessingStep(ctx, repo.StartMeetingProcessingStepParams{
+			MeetingID: run.meetingID,
+			Step:      &id,
+			Attempts:  int32(run.attempt + 1),
+		})
+		if err != nil {
+			fmt.Printf("[ERROR] Failed to record processing step %s for meeting %s: %v\n", id, run.meetingID, err)
+		}
+		return fn(ctx)
+	})
+}
+
+func (i *Inngest) finishProcessing(ctx context.Context, meetingID uuid.UUID, status string, errMessage *string) error {
+	return i.queries.FinishMeetingProcessing(ctx, repo.FinishMeetingProcessingParams{
+		MeetingID: meetingID,
+		Status:    status,
+		Error:     errMessage,
+	})
+}
+
+// postProcessFailed marks the meeting's processing as failed once Inngest has
+// given up on a post-process run, so the failure is visible and can be
+// retried from the API.
+func (i *Inngest) postProcessFailed() error {
+	type FunctionFailedEventData struct {
+		FunctionID string `json:"function_id"`
+		RunID      string `json:"run_id"`
+		Error      struct {
+			Message string `json:"message"`
+		} `json:"error"`
+		Event struct {
+			Data postProcessEventData `json:"data"`
+		} `json:"event"`
+	}
+
+	expression := fmt.Sprintf("event.data.function_id == '%s'", postProcessFunctionID)
+	_, err := inngestgo.CreateFunction(
+		i.client,
+		inngestgo.FunctionOpts{
+			ID:   "post-process-meeting-failed",
+			Name: "Post Process Meeting Failed",
+		},
+		inngestgo.EventTrigger("inngest/function.failed", &expression),
+		func(ctx context.Context, input inngestgo.Input[FunctionFailedEventData]) (any, error) {
+			meetingID, err := uuid.Parse(input.Event.Data.Event.Data.MeetingId)
+			if err != nil {
+				return nil, err
+			}
+			message := input.Event.Data.Error.Message
+			fmt.Printf("[ERROR] Post-processing failed for meeting %s (run %s): %s\n", meetingID, input.Event.Data.RunID, message)
+
+			return nil, i.finishProcessing(ctx, meetingID, ProcessingFailed, &message)
+		},
+	)
+	return err
+}
@@ -0,0 +1,78 @@
+package inngest
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngestgo"
+	"github.com/inngest/inngestgo/step"
+	"github.com/rahulSailesh-shah/converSense/pkg/webhook"
+)
+
+// ScheduleReminder queues a reminder note for delivery once it is due.
+func (i *Inngest) ScheduleReminder(ctx context.Context, noteID uuid.UUID, remindAt time.Time) error {
+	_, err := i.client.Send(ctx, inngestgo.Event{
+		Name: "conversense/reminder-scheduled",
+		Data: map[string]any{
+			"noteId":   noteID.String(),
+			"remindAt": remindAt.UTC().Format(time.RFC3339),
+		},
+	})
+	return err
+}
+
+// deliverReminder sleeps until a reminder is due and then announces it to
+// the organization's webhooks.
+func (i *Inngest) deliverReminder() error {
+	type ReminderScheduledEventData struct {
+		NoteID   string `json:"noteId"`
+		RemindAt string `json:"remindAt"`
+	}
+
+	_, err := inngestgo.CreateFunction(
+		i.client,
+		inngestgo.FunctionOpts{
+			ID:   "deliver-reminder",
+			Name: "Deliver Reminder",
+		},
+		inngestgo.EventTrigger("conversense/reminder-scheduled", nil),
+		func(ctx context.Context, input inngestgo.Input[ReminderScheduledEventData]) (any, error) {
+			noteID, err := uuid.Parse(input.Event.Data.NoteID)
+			if err != nil {
+				return nil, inngestgo.NoRetryError(err)
+			}
+			remindAt, err := time.Parse(time.RFC3339, input.Event.Data.RemindAt)
+			if err != nil {
+				return nil, inngestgo.NoRetryError(err)
+			}
+
+			step.SleepUntil(ctx, "wait-until-due", remindAt)
+
+			return step.Run(ctx, "publish", func(ctx context.Context) (string, error) {
+				note, err := i.queries.GetMeetingNote(ctx, noteID)
+				if err != nil {
+					return "", fmt.Errorf("failed to get reminder: %w", err)
+				}
+				meeting, err := i.queries.GetMeetingDetails(ctx, note.MeetingID)
+				if err != nil {
+					return "", fmt.Errorf("failed to get meeting: %w", err)
+				}
+				err = i.PublishWebhookEvent(ctx, meeting.OrgID, webhook.EventReminderDue, webhook.ReminderData{
+					ID:        note.ID,
+					MeetingID: note.MeetingID,
+					OrgID:     meeting.OrgID,
+					Content:   note.Content,
+					Author:    note.Author,
+					RemindAt:  remindAt,
+				})
+				if err != nil {
+					return "", err
+				}
+				return "published", nil
+			})
+		},
+	)
+	return err
+}
@@ -0,0 +1,115 @@
+package inngest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
+)
+
+// SentimentReport is the post-meeting sentiment of the conversation as a
+// whole and of each participant. Scores range from -1 (negative) to 1
+// (positive).
+type SentimentReport struct {
+	Overall      string                 `json:"overall"`
+	Score        float64                `json:"score"`
+	Summary      string                 `json:"summary"`
+	Participants []ParticipantSentiment `json:"participants"`
+}
+
+type ParticipantSentiment struct {
+	Name      string  `json:"name"`
+	Sentiment string  `json:"sentiment"`
+	Score     float64 `json:"score"`
+	Notes     string  `json:"notes"`
+}
+
+var sentimentLabels = []string{"positive", "negative", "neutral", "mixed"}
+
+const sentimentPrompt = `
+        You assess the sentiment of a meeting from its transcript.
+        Each transcript line is prefixed with its timestamp and the name of the person who spoke.
+
+        Respond with a single JSON object and nothing else, using exactly this schema:
+        {
+          "overall": "positive"|"negative"|"neutral"|"mixed",
+          "score": number,
+          "summary": string,
+          "participants": [{"name": string, "sentiment": "positive"|"negative"|"neutral"|"mixed", "score": number, "notes": string}]
+        }
+
+        - score is between -1 (very negative) and 1 (very positive).
+        - summary is two or three sentences on the tone of the meeting and how it changed.
+        - participants has one entry per person who spoke, excluding the AI agent; notes say briefly what drove their sentiment.
+
+        Transcript:\n
+        %s`
+
+// extractSentiment asks the summary model for the meeting's sentiment
+// report. Like the insights, the answer is validated so a malformed one
+// fails the step and gets retried.
+func (i *Inngest) extractSentiment(ctx context.Context, transcript *SessionTranscript) (*SentimentReport, error) {
+	var report SentimentReport
+	err := i.summaryModel.CompleteJSON(ctx, &report, llm.User(fmt.Sprintf(sentimentPrompt, formatTranscript(transcript))))
+	if err != nil {
+		return nil, err
+	}
+	if err := report.Validate(); err != nil {
+		return nil, err
+	}
+	return &report, nil
+}
+
+// Validate checks the report against the schema the model was given.
+func (r *SentimentReport) Validate() error {
+	if err := validateSentiment(r.Overall, r.Score); err != nil {
+		return fmt.Errorf("overall %w", err)
+	}
+	if strings.TrimSpace(r.Summary) == "" {
+		return fmt.Errorf("sentiment report has no summary")
+	}
+	for idx, participant := range r.Participants {
+		if strings.TrimSpace(participant.Name) == "" {
+			return fmt.Errorf("participant %d has no name", idx)
+		}
+		if err := validateSentiment(participant.Sentiment, participant.Score); err != nil {
+			return fmt.Errorf("participant %d %w", idx, err)
+		}
+	}
+	return nil
+}
+
+func validateSentiment(label string, score float64) error {
+	if !slices.Contains(sentimentLabels, label) {
+		return fmt.Errorf("sentiment %q is not one of %s", label, strings.Join(sentimentLabels, ", "))
+	}
+	if score < -1 || score > 1 {
+		return fmt.Errorf("sentiment score %v is outside -1 to 1", score)
+	}
+	return nil
+}
+
+// saveSentiment replaces the meeting's sentiment report.
+func (i *Inngest) saveSentiment(ctx context.Context, meetingID uuid.UUID, report *SentimentReport) error {
+	if report.Participants == nil {
+		report.Participants = []ParticipantSentiment{}
+	}
+	participants, err := json.Marshal(report.Participants)
+	if err != nil {
+		return err
+	}
+	modelName := i.summaryModel.Name()
+	return i.queries.SaveMeetingSentimentReport(ctx, repo.SaveMeetingSentimentReportParams{
+		MeetingID:    meetingID,
+		Overall:      report.Overall,
+		Score:        report.Score,
+		Summary:      report.Summary,
+		Participants: participants,
+		Model:        &modelName,
+	})
+}
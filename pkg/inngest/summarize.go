@@ -0,0 +1,257 @@
+package inngest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+	"github.com/rahulSailesh-shah/converSense/pkg/agentconfig"
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
+)
+
+// Long transcripts are summarized map-reduce style: each chunk is summarized
+// in its own step, then the partial summaries are merged into the final one.
+const (
+	// chunkTokenBudget caps the estimated transcript tokens per chunk.
+	chunkTokenBudget = 6000
+	// chunkMaxDuration caps the meeting time a chunk covers, so each partial
+	// summary maps onto a readable timestamp range.
+	chunkMaxDuration = 20 * time.Minute
+)
+
+// TranscriptChunk is a contiguous, time-bounded slice of a transcript.
+type TranscriptChunk struct {
+	Index    int                        `json:"index"`
+	Start    time.Time                  `json:"start"`
+	End      time.Time                  `json:"end"`
+	Segments []SessionTranscriptSegment `json:"segments"`
+}
+
+// ChunkSummary is the partial summary of one chunk.
+type ChunkSummary struct {
+	Index   int       `json:"index"`
+	Start   time.Time `json:"start"`
+	End     time.Time `json:"end"`
+	Summary string    `json:"summary"`
+}
+
+// estimateTokens approximates the token count of text at four characters per
+// token, which is close enough for budgeting prompts.
+func estimateTokens(text string) int {
+	return len(text)/4 + 1
+}
+
+func formatSegment(segment SessionTranscriptSegment) string {
+	return fmt.Sprintf("[%s] %s: %s\n", segment.Timestamp.Format("15:04:05"), segment.Name, segment.Content)
+}
+
+func formatTranscript(transcript *SessionTranscript) string {
+	var fullText strings.Builder
+	for _, segment := range transcript.Segments {
+		fullText.WriteString(formatSegment(segment))
+	}
+	return fullText.String()
+}
+
+// chunkTranscript splits a transcript into chunks of at most tokenBudget
+// estimated tokens and maxDuration of meeting time. A single segment over
+// the budget gets a chunk of its own rather than being split mid-sentence.
+func chunkTranscript(transcript *SessionTranscript, tokenBudget int, maxDuration time.Duration) []TranscriptChunk {
+	var chunks []TranscriptChunk
+	var current *TranscriptChunk
+	tokens := 0
+
+	for _, segment := range transcript.Segments {
+		segmentTokens := estimateTokens(formatSegment(segment))
+		if current != nil && (tokens+segmentTokens > tokenBudget || segment.Timestamp.Sub(current.Start) > maxDuration) {
+			chunks = append(chunks, *current)
+			current = nil
+		}
+		if current == nil {
+			current = &TranscriptChunk{
+				Index: len(chunks),
+				Start: segment.Timestamp,
+			}
+			tokens = 0
+		}
+		current.Segments = append(current.Segments, segment)
+		current.End = segment.Timestamp
+		tokens += segmentTokens
+	}
+	if current != nil {
+		chunks = append(chunks, *current)
+	}
+	return chunks
+}
+
+// summarizeChunks runs the map and reduce steps over the chunks of a long
+// transcript.
+func (i *Inngest) summarizeChunks(ctx context.Context, run *processingRun, model *llm.Model, chunks []TranscriptChunk,
+	template agentconfig.SummaryTemplate,
+) (string, error) {
+	partials := make([]ChunkSummary, 0, len(chunks))
+	for _, chunk := range chunks {
+		partial, err := runStep(ctx, run, fmt.Sprintf("summarize-chunk-%d", chunk.Index), func(ctx context.Context) (*ChunkSummary, error) {
+			return i.summarizeChunk(ctx, model, chunk)
+		})
+		if err != nil {
+			return "", err
+		}
+		partials = append(partials, *partial)
+	}
+	fmt.Println("[---] Transcript chunks summarized", "chunks", len(partials))
+
+	return runStep(ctx, run, "reduce-summary", func(ctx context.Context) (string, error) {
+		return i.reduceSummaries(ctx, model, partials, template)
+	})
+}
+
+func (i *Inngest) summarizeChunk(ctx context.Context, model *llm.Model, chunk TranscriptChunk) (*ChunkSummary, error) {
+	var text strings.Builder
+	for _, segment := range chunk.Segments {
+		text.WriteString(formatSegment(segment))
+	}
+
+	prompt := fmt.Sprintf(`
+        You are an expert summarizer. You are given one part of a longer meeting transcript, covering %s to %s.
+        Each transcript line is prefixed with its timestamp and the name of the person who spoke. Attribute opinions, decisions and commitments to the people who made them.
+
+        Summarize this part as markdown bullet points grouped under short thematic headings. Start every heading with the timestamp range it covers, for example:
+
+        #### 00:05:00 - 00:12:30 Pricing discussion
+        - Main point raised and by whom
+        - Decision or follow-up agreed
+
+        Only use timestamps that appear in the transcript. Do not write an introduction or conclusion.
+
+        Transcript:\n
+        %s`, chunk.Start.Format("15:04:05"), chunk.End.Format("15:04:05"), text.String())
+
+	summary, err := model.Complete(ctx, llm.User(prompt))
+	if err != nil {
+		return nil, err
+	}
+	return &ChunkSummary{
+		Index:   chunk.Index,
+		Start:   chunk.Start,
+		End:     chunk.End,
+		Summary: summary,
+	}, nil
+}
+
+func (i *Inngest) reduceSummaries(ctx context.Context, model *llm.Model, partials []ChunkSummary, template agentconfig.SummaryTemplate) (string, error) {
+	var parts strings.Builder
+	for _, partial := range partials {
+		parts.WriteString(fmt.Sprintf("Part %d (%s - %s):\n%s\n\n", partial.Index+1,
+			partial.Start.Format("15:04:05"), partial.End.Format("15:04:05"), partial.Summary))
+	}
+
+	prompt := fmt.Sprintf(`
+        You are an expert summarizer. You write readable, concise, simple content. You are given the summaries of consecutive parts of one long meeting, in order.
+        Merge them into a single summary of the whole meeting. Keep the timestamp ranges from the part summaries; when a theme spans several parts, its range runs from its first to its last timestamp.
+
+        Use the following markdown structure for every output:
+
+        %s
+        Part summaries:\n
+        %s`, template.Render(), parts.String())
+
+	return model.Complete(ctx, llm.User(prompt))
+}
+
+// summarizeTranscript summarizes a whole transcript in one request.
+func (i *Inngest) summarizeTranscript(ctx context.Context, model *llm.Model, transcript *SessionTranscript,
+	template agentconfig.SummaryTemplate,
+) (string, error) {
+	return model.Complete(ctx, llm.User(summaryPrompt(template, formatTranscript(transcript))))
+}
+
+// summaryPrompt asks for a summary of a whole transcript in the structure of
+// the given template.
+func summaryPrompt(template agentconfig.SummaryTemplate, transcript string) string {
+	return fmt.Sprintf(`
+        You are an expert summarizer. You write readable, concise, simple content. You are given a transcript of a meeting and you need to summarize it.
+        Each transcript line is prefixed with its timestamp and the name of the person who spoke. Attribute opinions, decisions and commitments to the people who made them.
+
+        Use the following markdown structure for every output:
+
+        %s
+        Transcript:\n
+        %s`, template.Render(), transcript)
+}
+
+// summaryTemplateFor resolves the summary template from the agent
+// configuration the meeting ran with, falling back to the agent's current
+// settings for meetings that were never pinned to a version. A template
+// requested for a reprocess takes precedence.
+func (i *Inngest) summaryTemplateFor(ctx context.Context, meeting *repo.GetMeetingDetailsRow, requested string) (agentconfig.SummaryTemplate, error) {
+	if requested != "" {
+		template, ok := agentconfig.GetSummaryTemplate(requested)
+		if !ok {
+			return agentconfig.SummaryTemplate{}, fmt.Errorf("unknown summary template %q", requested)
+		}
+		return template, nil
+	}
+
+	settingsData := meeting.AgentSettings
+	if meeting.AgentVersionID != nil {
+		version, err := i.queries.GetAgentVersionByID(ctx, *meeting.AgentVersionID)
+		if err != nil {
+			return agentconfig.SummaryTemplate{}, err
+		}
+		settingsData = version.Settings
+	}
+	settings, err := agentconfig.ParseSettings(settingsData)
+	if err != nil {
+		return agentconfig.SummaryTemplate{}, err
+	}
+	return agentconfig.SummaryTemplateFor(settings), nil
+}
+
+// saveSummary writes a new summary onto the meeting and records it as the
+// active version in the meeting's summary history, in one transaction so a
+// retried step neither duplicates a version nor leaves it inactive.
+func (i *Inngest) saveSummary(ctx context.Context, meeting *repo.GetMeetingDetailsRow, summary string,
+	template agentconfig.SummaryTemplate, model *llm.Model, requestedBy string,
+) error {
+	tx, err := i.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+	qtx := i.queries.WithTx(tx)
+
+	err = qtx.SetMeetingSummary(ctx, repo.SetMeetingSummaryParams{
+		ID:      meeting.ID,
+		Summary: &summary,
+	})
+	if err != nil {
+		return err
+	}
+
+	templateID := template.ID
+	modelName := model.Name()
+	params := repo.CreateMeetingSummaryParams{
+		MeetingID: meeting.ID,
+		Content:   summary,
+		Template:  &templateID,
+		Model:     &modelName,
+	}
+	if requestedBy != "" {
+		params.CreatedBy = &requestedBy
+	}
+	version, err := qtx.CreateMeetingSummary(ctx, params)
+	if err != nil {
+		return err
+	}
+	_, err = qtx.ActivateMeetingSummary(ctx, repo.ActivateMeetingSummaryParams{
+		ID:        version.ID,
+		MeetingID: meeting.ID,
+	})
+	if err != nil {
+		return err
+	}
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,162 @@
+package inngest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/inngest/inngestgo"
+	"github.com/inngest/inngestgo/step"
+	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+	"github.com/rahulSailesh-shah/converSense/pkg/webhook"
+)
+
+// webhookRetries is how many times Inngest retries a failed delivery, with
+// exponential backoff between attempts.
+const webhookRetries = 8
+
+// PublishWebhookEvent records a delivery for every endpoint of the
+// organization subscribed to event and queues them for sending.
+func (i *Inngest) PublishWebhookEvent(ctx context.Context, orgID uuid.UUID, event string, data any) error {
+	endpoints, err := i.queries.GetWebhookEndpointsForEvent(ctx, repo.GetWebhookEndpointsForEventParams{
+		OrgID: orgID,
+		Event: event,
+	})
+	if err != nil {
+		return err
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	payload, err := webhook.NewPayload(event, data)
+	if err != nil {
+		return err
+	}
+	for _, endpoint := range endpoints {
+		delivery, err := i.queries.CreateWebhookDelivery(ctx, repo.CreateWebhookDeliveryParams{
+			EndpointID: endpoint.ID,
+			Event:      event,
+			Payload:    payload,
+		})
+		if err != nil {
+			return err
+		}
+		if err := i.DeliverWebhook(ctx, delivery.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// publishSummaryWebhooks announces a meeting's summary and action items once
+// post-processing has saved them. Only the steps the run regenerated are
+// announced, so a reprocess that skipped the summary does not report it as
+// updated.
+func (i *Inngest) publishSummaryWebhooks(ctx context.Context, meeting *repo.GetMeetingDetailsRow, summary string,
+	options PostProcessOptions,
+) error {
+	if options.runs(StepSummary) {
+		err := i.PublishWebhookEvent(ctx, meeting.OrgID, webhook.EventSummaryReady, webhook.SummaryData{
+			MeetingID: meeting.ID,
+			OrgID:     meeting.OrgID,
+			Summary:   summary,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if !options.runs(StepActionItems) {
+		return nil
+	}
+
+	items, err := i.queries.GetMeetingActionItems(ctx, meeting.ID)
+	if err != nil {
+		return err
+	}
+	actionItems := make([]webhook.ActionItem, 0, len(items))
+	for _, item := range items {
+		actionItems = append(actionItems, webhook.ActionItem{
+			ID:          item.ID,
+			Description: item.Description,
+			Owner:       item.Owner,
+			DueDate:     item.DueDate,
+			Status:      item.Status,
+		})
+	}
+	return i.PublishWebhookEvent(ctx, meeting.OrgID, webhook.EventActionItemsReady, webhook.ActionItemsData{
+		MeetingID:   meeting.ID,
+		OrgID:       meeting.OrgID,
+		ActionItems: actionItems,
+	})
+}
+
+// DeliverWebhook queues a recorded delivery for sending.
+func (i *Inngest) DeliverWebhook(ctx context.Context, deliveryID uuid.UUID) error {
+	_, err := i.client.Send(ctx, inngestgo.Event{
+		Name: "conversense/deliver-webhook",
+		Data: map[string]any{
+			"deliveryId": deliveryID.String(),
+		},
+	})
+	return err
+}
+
+func (i *Inngest) deliverWebhook() error {
+	type DeliverWebhookEventData struct {
+		DeliveryID string `json:"deliveryId"`
+	}
+
+	_, err := inngestgo.CreateFunction(
+		i.client,
+		inngestgo.FunctionOpts{
+			ID:      "deliver-webhook",
+			Name:    "Deliver Webhook",
+			Retries: inngestgo.IntPtr(webhookRetries),
+		},
+		inngestgo.EventTrigger("conversense/deliver-webhook", nil),
+		func(ctx context.Context, input inngestgo.Input[DeliverWebhookEventData]) (any, error) {
+			deliveryID, err := uuid.Parse(input.Event.Data.DeliveryID)
+			if err != nil {
+				return nil, inngestgo.NoRetryError(err)
+			}
+
+			return step.Run(ctx, "send", func(ctx context.Context) (string, error) {
+				delivery, err := i.queries.GetWebhookDelivery(ctx, deliveryID)
+				if err != nil {
+					return "", err
+				}
+				endpoint, err := i.queries.GetWebhookEndpoint(ctx, delivery.EndpointID)
+				if err != nil {
+					return "", err
+				}
+				if !endpoint.Active {
+					return "skipped", nil
+				}
+
+				statusCode, sendErr := webhook.Send(ctx, endpoint.Url, endpoint.Secret, delivery.ID, delivery.Event, delivery.Payload)
+				attempt := repo.RecordWebhookDeliveryAttemptParams{
+					ID:     delivery.ID,
+					Status: "succeeded",
+				}
+				if statusCode != 0 {
+					code := int32(statusCode)
+					attempt.ResponseStatus = &code
+				}
+				if sendErr != nil {
+					message := sendErr.Error()
+					attempt.Status = "failed"
+					attempt.LastError = &message
+				}
+				if _, err := i.queries.RecordWebhookDeliveryAttempt(ctx, attempt); err != nil {
+					fmt.Printf("[ERROR] Failed to record webhook delivery %s: %v\n", delivery.ID, err)
+				}
+				if sendErr != nil {
+					return "", sendErr
+				}
+				return attempt.Status, nil
+			})
+		},
+	)
+	return err
+}
@@ -0,0 +1,121 @@
+package knowledge
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
+)
+
+// Supported document content types.
+const (
+	ContentTypeText     = "text/plain"
+	ContentTypeMarkdown = "text/markdown"
+	ContentTypePDF      = "application/pdf"
+)
+
+const (
+	// MaxDocumentBytes caps the size of an uploaded document.
+	MaxDocumentBytes  = 10 << 20
+	maxDocumentChunks = 500
+	maxChunkChars     = 1500
+)
+
+var contentTypesByExtension = map[string]string{
+	".txt":      ContentTypeText,
+	".text":     ContentTypeText,
+	".md":       ContentTypeMarkdown,
+	".markdown": ContentTypeMarkdown,
+	".pdf":      ContentTypePDF,
+}
+
+// ContentTypeFor returns the content type of a supported document by its
+// file name.
+func ContentTypeFor(filename string) (string, error) {
+	contentType, ok := contentTypesByExtension[strings.ToLower(filepath.Ext(filename))]
+	if !ok {
+		return "", fmt.Errorf("unsupported document type %q, expected .txt, .md or .pdf", filepath.Ext(filename))
+	}
+	return contentType, nil
+}
+
+// extractText returns the plain text of a document. PDFs are transcribed by
+// the document model since their text layer cannot be read without a PDF
+// parser.
+func extractText(ctx context.Context, model *llm.Model, contentType string, data []byte) (string, error) {
+	switch contentType {
+	case ContentTypeText, ContentTypeMarkdown:
+		if !utf8.Valid(data) {
+			return "", fmt.Errorf("document is not valid UTF-8 text")
+		}
+		return string(data), nil
+	case ContentTypePDF:
+		text, err := model.Complete(ctx, llm.UserWithAttachments(
+			"Extract all text from this document as markdown. Keep headings, lists and tables. Output only the document text.",
+			llm.Attachment{MIMEType: ContentTypePDF, Data: data},
+		))
+		if err != nil {
+			return "", fmt.Errorf("failed to extract PDF text: %w", err)
+		}
+		return text, nil
+	default:
+		return "", fmt.Errorf("unsupported content type %q", contentType)
+	}
+}
+
+// chunkText splits text into chunks of at most maxChunkChars, packing whole
+// paragraphs together and only splitting paragraphs that are too long.
+func chunkText(text string) []string {
+	var chunks []string
+	var current strings.Builder
+	flush := func() {
+		if chunk := strings.TrimSpace(current.String()); chunk != "" {
+			chunks = append(chunks, chunk)
+		}
+		current.Reset()
+	}
+
+	for _, paragraph := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n\n") {
+		paragraph = strings.TrimSpace(paragraph)
+		if paragraph == "" {
+			continue
+		}
+		for _, piece := range splitLong(paragraph) {
+			if current.Len() > 0 && current.Len()+len(piece)+2 > maxChunkChars {
+				flush()
+			}
+			if current.Len() > 0 {
+				current.WriteString("\n\n")
+			}
+			current.WriteString(piece)
+		}
+	}
+	flush()
+	return chunks
+}
+
+// splitLong breaks a paragraph longer than maxChunkChars on word boundaries.
+func splitLong(paragraph string) []string {
+	if len(paragraph) <= maxChunkChars {
+		return []string{paragraph}
+	}
+	var pieces []string
+	var current strings.Builder
+	for _, word := range strings.Fields(paragraph) {
+		if current.Len() > 0 && current.Len()+len(word)+1 > maxChunkChars {
+			pieces = append(pieces, current.String())
+			current.Reset()
+		}
+		if current.Len() > 0 {
+			current.WriteByte(' ')
+		}
+		current.WriteString(word)
+	}
+	if current.Len() > 0 {
+		pieces = append(pieces, current.String())
+	}
+	return pieces
+}
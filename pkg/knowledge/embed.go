@@ -0,0 +1,73 @@
+package knowledge
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
+)
+
+const (
+	// embeddingDimensions must match the vector column in agent_document_chunk.
+	embeddingDimensions = 768
+	// embedBatchSize is the most texts the API embeds in one request.
+	embedBatchSize = 100
+)
+
+func embedDocuments(ctx context.Context, model *llm.EmbeddingModel, title string, chunks []string) ([][]float32, error) {
+	embeddings := make([][]float32, 0, len(chunks))
+	for start := 0; start < len(chunks); start += embedBatchSize {
+		end := min(start+embedBatchSize, len(chunks))
+		batch, err := embed(ctx, model, llm.EmbedRequest{
+			Texts: chunks[start:end],
+			Task:  llm.EmbedDocument,
+			Title: title,
+		})
+		if err != nil {
+			return nil, err
+		}
+		embeddings = append(embeddings, batch...)
+	}
+	return embeddings, nil
+}
+
+func embedQuery(ctx context.Context, model *llm.EmbeddingModel, query string) ([]float32, error) {
+	embeddings, err := embed(ctx, model, llm.EmbedRequest{
+		Texts: []string{query},
+		Task:  llm.EmbedQuery,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return embeddings[0], nil
+}
+
+func embed(ctx context.Context, model *llm.EmbeddingModel, request llm.EmbedRequest) ([][]float32, error) {
+	request.Dimensions = embeddingDimensions
+	embeddings, err := model.Embed(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for _, embedding := range embeddings {
+		if len(embedding) != embeddingDimensions {
+			return nil, fmt.Errorf("expected %d embedding dimensions, got %d", embeddingDimensions, len(embedding))
+		}
+	}
+	return embeddings, nil
+}
+
+// vectorLiteral formats an embedding in pgvector's text representation.
+func vectorLiteral(values []float32) string {
+	var sb strings.Builder
+	sb.WriteByte('[')
+	for i, value := range values {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(strconv.FormatFloat(float64(value), 'f', -1, 32))
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
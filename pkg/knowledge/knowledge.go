@@ -0,0 +1,129 @@
+// Package knowledge stores documents uploaded to an agent as embedded chunks
+// and retrieves the ones relevant to a question.
+package knowledge
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
+)
+
+// maxChunkDistance drops search results whose cosine distance to the query
+// is too large to be useful context.
+const maxChunkDistance = 0.65
+
+type Base struct {
+	db      *pgxpool.Pool
+	queries *repo.Queries
+	// documentModel transcribes PDFs, embeddingModel embeds chunks and queries
+	documentModel  *llm.Model
+	embeddingModel *llm.EmbeddingModel
+}
+
+func NewBase(db *pgxpool.Pool, queries *repo.Queries, documentModel *llm.Model, embeddingModel *llm.EmbeddingModel) *Base {
+	return &Base{
+		db:             db,
+		queries:        queries,
+		documentModel:  documentModel,
+		embeddingModel: embeddingModel,
+	}
+}
+
+// AddDocument extracts, chunks and embeds a document and stores it for the
+// agent. Either the whole document is stored or nothing is.
+func (b *Base) AddDocument(ctx context.Context, agentID uuid.UUID, name string, contentType string, data []byte) (repo.AgentDocument, error) {
+	text, err := extractText(ctx, b.documentModel, contentType, data)
+	if err != nil {
+		return repo.AgentDocument{}, err
+	}
+	chunks := chunkText(text)
+	if len(chunks) == 0 {
+		return repo.AgentDocument{}, fmt.Errorf("document contains no text")
+	}
+	if len(chunks) > maxDocumentChunks {
+		return repo.AgentDocument{}, fmt.Errorf("document is too large: %d chunks, at most %d allowed", len(chunks), maxDocumentChunks)
+	}
+
+	embeddings, err := embedDocuments(ctx, b.embeddingModel, name, chunks)
+	if err != nil {
+		return repo.AgentDocument{}, err
+	}
+
+	tx, err := b.db.Begin(ctx)
+	if err != nil {
+		return repo.AgentDocument{}, err
+	}
+	defer tx.Rollback(ctx)
+	qtx := b.queries.WithTx(tx)
+
+	document, err := qtx.CreateAgentDocument(ctx, repo.CreateAgentDocumentParams{
+		AgentID:     agentID,
+		Name:        name,
+		ContentType: contentType,
+		SizeBytes:   int64(len(data)),
+		ChunkCount:  int32(len(chunks)),
+	})
+	if err != nil {
+		return repo.AgentDocument{}, fmt.Errorf("failed to create document: %w", err)
+	}
+	for i, chunk := range chunks {
+		if err := qtx.CreateAgentDocumentChunk(ctx, repo.CreateAgentDocumentChunkParams{
+			DocumentID: document.ID,
+			AgentID:    agentID,
+			ChunkIndex: int32(i),
+			Content:    chunk,
+			Embedding:  vectorLiteral(embeddings[i]),
+		}); err != nil {
+			return repo.AgentDocument{}, fmt.Errorf("failed to store chunk %d: %w", i, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return repo.AgentDocument{}, err
+	}
+	return document, nil
+}
+
+// Search returns up to limit chunks of the agent's documents relevant to
+// query, closest first.
+func (b *Base) Search(ctx context.Context, agentID uuid.UUID, query string, limit int) ([]repo.SearchAgentDocumentChunksRow, error) {
+	if strings.TrimSpace(query) == "" {
+		return []repo.SearchAgentDocumentChunksRow{}, nil
+	}
+	embedding, err := embedQuery(ctx, b.embeddingModel, query)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := b.queries.SearchAgentDocumentChunks(ctx, repo.SearchAgentDocumentChunksParams{
+		Embedding:   vectorLiteral(embedding),
+		AgentID:     agentID,
+		ResultLimit: int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to search documents: %w", err)
+	}
+
+	relevant := make([]repo.SearchAgentDocumentChunksRow, 0, len(rows))
+	for _, row := range rows {
+		if row.Distance <= maxChunkDistance {
+			relevant = append(relevant, row)
+		}
+	}
+	return relevant, nil
+}
+
+// FormatChunks renders search results as a prompt section, one excerpt per
+// chunk labelled with its document.
+func FormatChunks(chunks []repo.SearchAgentDocumentChunksRow) string {
+	var sb strings.Builder
+	for _, chunk := range chunks {
+		sb.WriteString(fmt.Sprintf("[%s]\n%s\n\n", chunk.DocumentName, chunk.Content))
+	}
+	return strings.TrimSpace(sb.String())
+}
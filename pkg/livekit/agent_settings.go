@@ -0,0 +1,72 @@
+package livekit
+
+import (
+	"github.com/rahulSailesh-shah/converSense/pkg/agentconfig"
+	"github.com/rahulSailesh-shah/converSense/pkg/config"
+	"google.golang.org/genai"
+)
+
+const defaultGeminiRealtimeModel = "gemini-2.5-flash-native-audio-preview-09-2025"
+
+// RealtimeModelTypeFor picks the provider matching the agent's response
+// modality, or fallback when the agent has no preference.
+func RealtimeModelTypeFor(settings agentconfig.Settings, fallback RealtimeModelType) RealtimeModelType {
+	switch settings.ResponseModality {
+	case agentconfig.ModalityAudio:
+		return RealtimeModelGeminiAudio
+	case agentconfig.ModalityText:
+		return RealtimeModelGeminiText
+	default:
+		return fallback
+	}
+}
+
+// realtimeModelName resolves the Live model: the agent's choice, then the
+// server configuration, then the built-in default.
+func realtimeModelName(cfg *config.GeminiConfig, settings agentconfig.Settings) string {
+	if settings.RealtimeModel != "" {
+		return settings.RealtimeModel
+	}
+	if cfg.RealtimeModel != "" {
+		return cfg.RealtimeModel
+	}
+	return defaultGeminiRealtimeModel
+}
+
+// applyAgentSettings copies the agent's generation settings onto a Live
+// connect config. Voice only applies when the model answers with audio.
+func applyAgentSettings(liveConfig *genai.LiveConnectConfig, settings agentconfig.Settings, audio bool) {
+	thinkingBudget := int32(0)
+	if settings.ThinkingBudget != nil {
+		thinkingBudget = *settings.ThinkingBudget
+	}
+	liveConfig.ThinkingConfig = &genai.ThinkingConfig{
+		ThinkingBudget: &thinkingBudget,
+	}
+	liveConfig.Temperature = settings.Temperature
+
+	if settings.LanguageCode == "" && (!audio || settings.VoiceName == "") {
+		return
+	}
+	speechConfig := &genai.SpeechConfig{
+		LanguageCode: settings.LanguageCode,
+	}
+	if audio && settings.VoiceName != "" {
+		speechConfig.VoiceConfig = &genai.VoiceConfig{
+			PrebuiltVoiceConfig: &genai.PrebuiltVoiceConfig{
+				VoiceName: settings.VoiceName,
+			},
+		}
+	}
+	liveConfig.SpeechConfig = speechConfig
+}
+
+// agentInstructions adds a language hint to the agent's instructions, since
+// the speech language code alone does not stop the model from switching
+// languages mid-conversation.
+func agentInstructions(instructions string, settings agentconfig.Settings) string {
+	if settings.LanguageCode == "" {
+		return instructions
+	}
+	return instructions + "\n\nAlways respond in the language with BCP-47 code " + settings.LanguageCode + ", unless a participant explicitly asks you to switch."
+}
@@ -5,41 +5,57 @@ import (
 	"encoding/binary"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/livekit/media-sdk"
 	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+	"github.com/rahulSailesh-shah/converSense/pkg/agentconfig"
 	"github.com/rahulSailesh-shah/converSense/pkg/config"
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
 	sentimentanalyzer "github.com/rahulSailesh-shah/converSense/pkg/sentiment-analyzer"
+	"go.uber.org/atomic"
 	"google.golang.org/genai"
 )
 
 type GeminiRealtimeAPIHandler struct {
-	client             *genai.Client
-	session            *genai.Session
-	ctx                context.Context
-	cancel             context.CancelFunc
-	cb                 *GeminiRealtimeAPIHandlerCallbacks
-	sentimentAnalyzer  sentimentanalyzer.SentimentAnalyzer
-	transcript         *SessionTranscript
-	userDetails        *repo.User
-	meetingDetails     *repo.GetMeetingRow
-	currentUserContent string // Accumulate user chunks
-	currentBotContent  string // Accumulate bot chunks
-	currentTurnStart   time.Time
+	conn              *liveConnection
+	summary           *rollingSummary
+	ctx               context.Context
+	cancel            context.CancelFunc
+	cb                *GeminiRealtimeAPIHandlerCallbacks
+	tools             *ToolRegistry
+	instructions      string // agent instructions with the language hint applied
+	sentimentAnalyzer sentimentanalyzer.SentimentAnalyzer
+	transcriptMu      sync.Mutex
+	transcript        *SessionTranscript
+	inTurn            atomic.Bool // set while a turn is being accumulated
+	userDetails       *repo.User
+	meetingDetails    *repo.GetMeetingRow
+	userTurn          userTurnBuffer // Accumulate user chunks per speaker
+	currentBotContent string         // Accumulate bot chunks
+	currentTurnStart  time.Time
 }
 
 type TranscriptDataStream struct {
-	Role      string    `json:"role"`      // "user" or "ai"
-	Name      string    `json:"name"`      // Speaker's name
-	Content   string    `json:"content"`   // Transcript text
-	Timestamp time.Time `json:"timestamp"` // When the segment was captured
+	Role      string    `json:"role"`               // "user" or "ai"
+	Identity  string    `json:"identity,omitempty"` // LiveKit participant identity of the speaker
+	Name      string    `json:"name"`               // Speaker's name
+	Content   string    `json:"content"`            // Transcript text
+	Timestamp time.Time `json:"timestamp"`          // When the segment was captured
 }
 
 type GeminiRealtimeAPIHandlerCallbacks struct {
 	OnAudioReceived  func(audio media.PCM16Sample)
 	OnUserSentiment  func(result *sentimentanalyzer.SentimentResult)
 	OnUserTranscript func(result *TranscriptDataStream)
+	// OnToolResult reports each tool the agent ran and its outcome.
+	OnToolResult func(result *ToolResultEvent)
+	// OnConnectionStatus reports model connection drops and recoveries.
+	OnConnectionStatus func(event *ConnectionStatusEvent)
+	// ResolveSpeaker returns the participant who spoke between from and to,
+	// or the zero Speaker when unknown.
+	ResolveSpeaker func(from, to time.Time) Speaker
 }
 
 type SessionTranscript struct {
@@ -47,10 +63,11 @@ type SessionTranscript struct {
 }
 
 type SessionTranscriptSegment struct {
-	Role      string    `json:"role"`      // "user" or "ai"
-	Name      string    `json:"name"`      // Speaker's name
-	Content   string    `json:"content"`   // Transcript text
-	Timestamp time.Time `json:"timestamp"` // When the segment was captured
+	Role      string    `json:"role"`               // "user" or "ai"
+	Identity  string    `json:"identity,omitempty"` // LiveKit participant identity of the speaker
+	Name      string    `json:"name"`               // Speaker's name
+	Content   string    `json:"content"`            // Transcript text
+	Timestamp time.Time `json:"timestamp"`          // When the segment was captured
 }
 
 func NewGeminiRealtimeAPIHandler(parentCtx context.Context,
@@ -58,7 +75,10 @@ func NewGeminiRealtimeAPIHandler(parentCtx context.Context,
 	userDetails *repo.User,
 	meetingDetails *repo.GetMeetingRow,
 	cb *GeminiRealtimeAPIHandlerCallbacks,
+	tools *ToolRegistry,
+	settings agentconfig.Settings,
 	sentimentAnalyzer sentimentanalyzer.SentimentAnalyzer,
+	summaryModel *llm.Model,
 ) (*GeminiRealtimeAPIHandler, error) {
 	ctx, cancel := context.WithCancel(parentCtx)
 
@@ -71,35 +91,31 @@ func NewGeminiRealtimeAPIHandler(parentCtx context.Context,
 		return nil, fmt.Errorf("failed to create Gemini client: %w", err)
 	}
 
-	model := config.RealtimeModel
-	if model == "" {
-		model = "gemini-2.5-flash-native-audio-preview-09-2025"
-	}
-	thinkingBudget := int32(0)
-	systemInstructions := meetingDetails.AgentInstructions
-	session, err := client.Live.Connect(ctx, model, &genai.LiveConnectConfig{
+	systemInstructions := agentInstructions(meetingDetails.AgentInstructions, settings)
+	liveConfig := &genai.LiveConnectConfig{
 		SystemInstruction:        genai.NewContentFromText(systemInstructions, genai.RoleUser),
 		ResponseModalities:       []genai.Modality{genai.ModalityAudio},
 		InputAudioTranscription:  &genai.AudioTranscriptionConfig{},
 		OutputAudioTranscription: &genai.AudioTranscriptionConfig{},
-		ThinkingConfig: &genai.ThinkingConfig{
-			ThinkingBudget: &thinkingBudget,
-		},
-		Tools: []*genai.Tool{{
-			GoogleSearchRetrieval: &genai.GoogleSearchRetrieval{},
-		}},
-	})
+		ContextWindowCompression: contextWindowCompression(config),
+		Tools:                    liveTools(tools),
+	}
+	applyAgentSettings(liveConfig, settings, true)
+	conn, err := newLiveConnection(ctx, client, realtimeModelName(config, settings), liveConfig,
+		time.Duration(config.SessionRolloverMin)*time.Minute, cb)
 	if err != nil {
 		cancel()
 		return nil, fmt.Errorf("failed to connect session: %w", err)
 	}
 
 	h := &GeminiRealtimeAPIHandler{
-		client:            client,
-		session:           session,
+		conn:              conn,
+		summary:           newRollingSummary(summaryModel),
 		ctx:               ctx,
 		cancel:            cancel,
 		cb:                cb,
+		tools:             tools,
+		instructions:      systemInstructions,
 		sentimentAnalyzer: sentimentAnalyzer,
 		userDetails:       userDetails,
 		meetingDetails:    meetingDetails,
@@ -119,7 +135,7 @@ func (h *GeminiRealtimeAPIHandler) SendAudioChunk(sample media.PCM16Sample) erro
 		binary.LittleEndian.PutUint16(bytes[i*2:], uint16(s))
 	}
 
-	err := h.session.SendRealtimeInput(genai.LiveRealtimeInput{
+	err := h.conn.sendRealtimeInput(genai.LiveRealtimeInput{
 		Audio: &genai.Blob{
 			Data:     bytes,
 			MIMEType: "audio/pcm;rate=16000",
@@ -132,27 +148,46 @@ func (h *GeminiRealtimeAPIHandler) SendAudioChunk(sample media.PCM16Sample) erro
 	return nil
 }
 
+func (h *GeminiRealtimeAPIHandler) SendText(text string) error {
+	return h.conn.sendRealtimeInput(genai.LiveRealtimeInput{
+		Text: text,
+	})
+}
+
 func (h *GeminiRealtimeAPIHandler) readMessages() {
-	for {
-		response, err := h.session.Receive()
-		if err != nil {
-			select {
-			case <-h.ctx.Done():
-				fmt.Println("[-] Session closed")
-				return
-			default:
-				fmt.Println("[-] Error receiving message:", err)
+	h.conn.run(liveConnectionHooks{
+		onMessage: h.handleMessage,
+		onDrop:    h.flushTurn,
+		instructions: func() string {
+			summary, err := h.summary.update(h.ctx, h.GetTranscript().Segments)
+			if err != nil {
+				fmt.Println("[-] Failed to update rolling summary:", err)
 			}
-			return
-		}
-		h.handleMessage(response)
-	}
+			return seededInstructions(h.instructions, summary)
+		},
+		busy: h.inTurn.Load,
+	})
 }
 
 func (h *GeminiRealtimeAPIHandler) handleMessage(response *genai.LiveServerMessage) {
+	if response.ToolCall != nil {
+		inv := ToolInvocation{
+			MeetingID: h.meetingDetails.ID,
+			AgentID:   h.meetingDetails.AgentID,
+			OrgID:     h.meetingDetails.OrgID,
+			UserID:    h.meetingDetails.UserID,
+			Speaker:   h.speaker(time.Now().Add(-maxUtteranceWindow), time.Now()),
+		}
+		go runToolCalls(h.ctx, h.conn, h.tools, inv, response.ToolCall, h.cb)
+	}
+
 	if response.ServerContent == nil {
 		return
 	}
+	if response.ServerContent.ModelTurn != nil || response.ServerContent.InputTranscription != nil ||
+		response.ServerContent.OutputTranscription != nil {
+		h.inTurn.Store(true)
+	}
 
 	// Accumulate output transcription chunks from the bot
 	if response.ServerContent.OutputTranscription != nil {
@@ -168,18 +203,20 @@ func (h *GeminiRealtimeAPIHandler) handleMessage(response *genai.LiveServerMessa
 		})
 	}
 
-	// Accumulate input transcription chunks from the user
+	// Accumulate input transcription chunks, attributed to whoever spoke during
+	// the audio the chunk covers
 	if response.ServerContent.InputTranscription != nil {
-		text := response.ServerContent.InputTranscription.Text
-		if text != "" {
-			h.currentUserContent += " " + text
+		now := time.Now()
+		h.userTurn.add(response.ServerContent.InputTranscription.Text, h.speaker(h.userTurn.window(now)), now)
+		if current := h.userTurn.current(); current.Content != "" {
+			h.cb.OnUserTranscript(&TranscriptDataStream{
+				Role:      current.Role,
+				Identity:  current.Identity,
+				Name:      current.Name,
+				Content:   current.Content,
+				Timestamp: current.Timestamp,
+			})
 		}
-		h.cb.OnUserTranscript(&TranscriptDataStream{
-			Role:      "user",
-			Name:      h.userDetails.Name,
-			Content:   strings.TrimSpace(h.currentUserContent),
-			Timestamp: h.currentTurnStart,
-		})
 	}
 
 	// Handle audio from the bot
@@ -199,47 +236,71 @@ func (h *GeminiRealtimeAPIHandler) handleMessage(response *genai.LiveServerMessa
 	// On turn completion, create segments from accumulated content
 	if response.ServerContent.TurnComplete {
 		fmt.Println("✅ Turn complete - ready for next input")
-		if h.currentUserContent != "" {
-			userSegment := SessionTranscriptSegment{
-				Role:      "user",
-				Name:      h.userDetails.Name,
-				Content:   strings.TrimSpace(h.currentUserContent),
-				Timestamp: h.currentTurnStart,
-			}
-			h.transcript.Segments = append(h.transcript.Segments, userSegment)
-		}
+		h.flushTurn()
+	}
+}
 
-		if h.currentBotContent != "" {
-			botSegment := SessionTranscriptSegment{
-				Role:      "ai",
-				Name:      h.meetingDetails.AgentName,
-				Content:   strings.TrimSpace(h.currentBotContent),
-				Timestamp: time.Now(),
-			}
-			h.transcript.Segments = append(h.transcript.Segments, botSegment)
+// flushTurn persists the accumulated turn to the transcript and runs
+// sentiment on what the participants said.
+func (h *GeminiRealtimeAPIHandler) flushTurn() {
+	userSegments := h.userTurn.flush()
+	h.transcriptMu.Lock()
+	h.transcript.Segments = append(h.transcript.Segments, userSegments...)
+	if h.currentBotContent != "" {
+		botSegment := SessionTranscriptSegment{
+			Role:      "ai",
+			Name:      h.meetingDetails.AgentName,
+			Content:   strings.TrimSpace(h.currentBotContent),
+			Timestamp: time.Now(),
 		}
+		h.transcript.Segments = append(h.transcript.Segments, botSegment)
+	}
+	h.transcriptMu.Unlock()
+	h.inTurn.Store(false)
 
-		userMessage := h.currentUserContent
-		h.currentUserContent = ""
-		h.currentBotContent = ""
-		h.currentTurnStart = time.Now()
-		if userMessage != "" {
-			res, err := h.sentimentAnalyzer.Analyze(h.ctx, userMessage, h.userDetails.Name)
-			if err != nil {
-				fmt.Println("Error analyzing sentiment:", err)
-			}
-			h.cb.OnUserSentiment(res)
-
+	h.currentBotContent = ""
+	h.currentTurnStart = time.Now()
+	for _, segment := range userSegments {
+		res, err := h.sentimentAnalyzer.Analyze(h.ctx, segment.Content, segment.Name)
+		if err != nil {
+			fmt.Println("Error analyzing sentiment:", err)
+			continue
 		}
-
+		h.cb.OnUserSentiment(res)
 	}
 }
 
+func (h *GeminiRealtimeAPIHandler) speaker(from, to time.Time) Speaker {
+	return resolveSpeaker(h.cb, h.userDetails, from, to)
+}
+
+// GetTranscript returns a snapshot of the transcript so far.
 func (h *GeminiRealtimeAPIHandler) GetTranscript() *SessionTranscript {
-	return h.transcript
+	h.transcriptMu.Lock()
+	defer h.transcriptMu.Unlock()
+	segments := make([]SessionTranscriptSegment, len(h.transcript.Segments))
+	copy(segments, h.transcript.Segments)
+	return &SessionTranscript{
+		Segments: segments,
+	}
 }
 
 func (h *GeminiRealtimeAPIHandler) Close() error {
 	h.cancel()
-	return h.session.Close()
+	return h.conn.close()
+}
+
+// resolveSpeaker attributes user input to the room participant who spoke
+// between from and to, falling back to the meeting owner when the room
+// cannot tell.
+func resolveSpeaker(cb *GeminiRealtimeAPIHandlerCallbacks, userDetails *repo.User, from, to time.Time) Speaker {
+	if cb != nil && cb.ResolveSpeaker != nil {
+		if speaker := cb.ResolveSpeaker(from, to); speaker.Identity != "" {
+			return speaker
+		}
+	}
+	return Speaker{
+		Identity: userDetails.ID,
+		Name:     userDetails.Name,
+	}
 }
@@ -5,12 +5,16 @@ import (
 	"encoding/binary"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/livekit/media-sdk"
 	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+	"github.com/rahulSailesh-shah/converSense/pkg/agentconfig"
 	"github.com/rahulSailesh-shah/converSense/pkg/config"
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
 	sentimentanalyzer "github.com/rahulSailesh-shah/converSense/pkg/sentiment-analyzer"
+	"go.uber.org/atomic"
 	"google.golang.org/genai"
 )
 
@@ -18,19 +22,23 @@ import (
 // It streams audio input to Gemini Live and consumes text responses instead of audio.
 // A small JSON context payload is sent upfront (hardcoded for now; replace with DB-derived state later).
 type GeminiRealtimeTextHandler struct {
-	client             *genai.Client
-	session            *genai.Session
-	ctx                context.Context
-	cancel             context.CancelFunc
-	cb                 *GeminiRealtimeAPIHandlerCallbacks
-	sentimentAnalyzer  sentimentanalyzer.SentimentAnalyzer
-	transcript         *SessionTranscript
-	userDetails        *repo.User
-	meetingDetails     *repo.GetMeetingRow
-	currentUserContent string
-	currentBotContent  string
-	currentTurnStart   time.Time
-	contextJSON        string
+	conn              *liveConnection
+	summary           *rollingSummary
+	ctx               context.Context
+	cancel            context.CancelFunc
+	cb                *GeminiRealtimeAPIHandlerCallbacks
+	tools             *ToolRegistry
+	instructions      string // agent instructions with the language hint applied
+	sentimentAnalyzer sentimentanalyzer.SentimentAnalyzer
+	transcriptMu      sync.Mutex
+	transcript        *SessionTranscript
+	inTurn            atomic.Bool // set while a turn is being accumulated
+	userDetails       *repo.User
+	meetingDetails    *repo.GetMeetingRow
+	userTurn          userTurnBuffer
+	currentBotContent string
+	currentTurnStart  time.Time
+	contextJSON       string
 }
 
 // NewGeminiRealtimeTextHandler connects a Live session configured for text output.
@@ -41,7 +49,10 @@ func NewGeminiRealtimeTextHandler(
 	userDetails *repo.User,
 	meetingDetails *repo.GetMeetingRow,
 	cb *GeminiRealtimeAPIHandlerCallbacks,
+	tools *ToolRegistry,
+	settings agentconfig.Settings,
 	sentimentAnalyzer sentimentanalyzer.SentimentAnalyzer,
+	summaryModel *llm.Model,
 ) (*GeminiRealtimeTextHandler, error) {
 	ctx, cancel := context.WithCancel(parentCtx)
 
@@ -53,40 +64,36 @@ func NewGeminiRealtimeTextHandler(
 		return nil, fmt.Errorf("failed to create Gemini client: %w", err)
 	}
 
-	model := cfg.RealtimeModel
-	if model == "" {
-		model = "gemini-2.5-flash-native-audio-preview-09-2025"
-	}
-	thinkingBudget := int32(0)
-
 	// Hardcoded JSON context for now; replace with DB-sourced state when ready.
 	contextJSON := fmt.Sprintf(`{"meeting_id":"%s","user":"%s","agent":"%s"}`,
 		meetingDetails.ID.String(), userDetails.Name, meetingDetails.AgentName)
 
-	session, err := client.Live.Connect(ctx, model, &genai.LiveConnectConfig{
-		SystemInstruction:       genai.NewContentFromText(meetingDetails.AgentInstructions, genai.RoleUser),
+	systemInstructions := agentInstructions(meetingDetails.AgentInstructions, settings)
+	liveConfig := &genai.LiveConnectConfig{
+		SystemInstruction:       genai.NewContentFromText(systemInstructions, genai.RoleUser),
 		ResponseModalities:      []genai.Modality{genai.ModalityText}, // request text output
 		InputAudioTranscription: &genai.AudioTranscriptionConfig{
 			// empty config enables transcription
 		},
-		ThinkingConfig: &genai.ThinkingConfig{
-			ThinkingBudget: &thinkingBudget,
-		},
-		Tools: []*genai.Tool{{
-			GoogleSearchRetrieval: &genai.GoogleSearchRetrieval{},
-		}},
-	})
+		ContextWindowCompression: contextWindowCompression(cfg),
+		Tools:                    liveTools(tools),
+	}
+	applyAgentSettings(liveConfig, settings, false)
+	conn, err := newLiveConnection(ctx, client, realtimeModelName(cfg, settings), liveConfig,
+		time.Duration(cfg.SessionRolloverMin)*time.Minute, cb)
 	if err != nil {
 		cancel()
 		return nil, fmt.Errorf("failed to connect session: %w", err)
 	}
 
 	h := &GeminiRealtimeTextHandler{
-		client:            client,
-		session:           session,
+		conn:              conn,
+		summary:           newRollingSummary(summaryModel),
 		ctx:               ctx,
 		cancel:            cancel,
 		cb:                cb,
+		tools:             tools,
+		instructions:      systemInstructions,
 		sentimentAnalyzer: sentimentAnalyzer,
 		userDetails:       userDetails,
 		meetingDetails:    meetingDetails,
@@ -113,7 +120,7 @@ func (h *GeminiRealtimeTextHandler) SendAudioChunk(sample media.PCM16Sample) err
 		binary.LittleEndian.PutUint16(bytes[i*2:], uint16(s))
 	}
 
-	err := h.session.SendRealtimeInput(genai.LiveRealtimeInput{
+	err := h.conn.sendRealtimeInput(genai.LiveRealtimeInput{
 		Audio: &genai.Blob{
 			Data:     bytes,
 			MIMEType: "audio/pcm;rate=16000",
@@ -127,32 +134,53 @@ func (h *GeminiRealtimeTextHandler) SendAudioChunk(sample media.PCM16Sample) err
 
 // SendText sends a text chunk (used here for JSON context or any extra user text).
 func (h *GeminiRealtimeTextHandler) SendText(text string) error {
-	return h.session.SendRealtimeInput(genai.LiveRealtimeInput{
+	return h.conn.sendRealtimeInput(genai.LiveRealtimeInput{
 		Text: text,
 	})
 }
 
 func (h *GeminiRealtimeTextHandler) readMessages() {
-	for {
-		response, err := h.session.Receive()
-		if err != nil {
-			select {
-			case <-h.ctx.Done():
-				fmt.Println("[-] Session closed")
-				return
-			default:
-				fmt.Println("[-] Error receiving message:", err)
+	h.conn.run(liveConnectionHooks{
+		onMessage: h.handleMessage,
+		onDrop:    h.flushTurn,
+		instructions: func() string {
+			summary, err := h.summary.update(h.ctx, h.GetTranscript().Segments)
+			if err != nil {
+				fmt.Println("[-] Failed to update rolling summary:", err)
 			}
-			return
-		}
-		h.handleMessage(response)
-	}
+			return seededInstructions(h.instructions, summary)
+		},
+		busy: h.inTurn.Load,
+		onReconnect: func(resumed bool) {
+			// A fresh session has lost the context sent at connect time.
+			if !resumed {
+				if err := h.SendText(h.contextJSON); err != nil {
+					fmt.Printf("[-] Failed to resend context JSON: %v\n", err)
+				}
+			}
+		},
+	})
 }
 
 func (h *GeminiRealtimeTextHandler) handleMessage(response *genai.LiveServerMessage) {
+	if response.ToolCall != nil {
+		inv := ToolInvocation{
+			MeetingID: h.meetingDetails.ID,
+			AgentID:   h.meetingDetails.AgentID,
+			OrgID:     h.meetingDetails.OrgID,
+			UserID:    h.meetingDetails.UserID,
+			Speaker:   h.speaker(time.Now().Add(-maxUtteranceWindow), time.Now()),
+		}
+		go runToolCalls(h.ctx, h.conn, h.tools, inv, response.ToolCall, h.cb)
+	}
+
 	if response.ServerContent == nil {
 		return
 	}
+	if response.ServerContent.ModelTurn != nil || response.ServerContent.InputTranscription != nil ||
+		response.ServerContent.OutputTranscription != nil {
+		h.inTurn.Store(true)
+	}
 
 	// Capture model text output from ModelTurn parts.
 	if response.ServerContent.ModelTurn != nil {
@@ -174,64 +202,75 @@ func (h *GeminiRealtimeTextHandler) handleMessage(response *genai.LiveServerMess
 		}
 	}
 
-	// Accumulate input transcription chunks from the user.
+	// Accumulate input transcription chunks, attributed to whoever spoke during
+	// the audio the chunk covers.
 	if response.ServerContent.InputTranscription != nil {
-		text := response.ServerContent.InputTranscription.Text
-		if text != "" {
-			h.currentUserContent += " " + text
+		now := time.Now()
+		h.userTurn.add(response.ServerContent.InputTranscription.Text, h.speaker(h.userTurn.window(now)), now)
+		if current := h.userTurn.current(); current.Content != "" {
+			h.cb.OnUserTranscript(&TranscriptDataStream{
+				Role:      current.Role,
+				Identity:  current.Identity,
+				Name:      current.Name,
+				Content:   current.Content,
+				Timestamp: current.Timestamp,
+			})
 		}
-		h.cb.OnUserTranscript(&TranscriptDataStream{
-			Role:      "user",
-			Name:      h.userDetails.Name,
-			Content:   strings.TrimSpace(h.currentUserContent),
-			Timestamp: h.currentTurnStart,
-		})
 	}
 
 	// On turn completion, persist accumulated content and run sentiment.
 	if response.ServerContent.TurnComplete {
 		fmt.Println("✅ Turn complete - ready for next input (text output mode)")
-		if h.currentUserContent != "" {
-			userSegment := SessionTranscriptSegment{
-				Role:      "user",
-				Name:      h.userDetails.Name,
-				Content:   strings.TrimSpace(h.currentUserContent),
-				Timestamp: h.currentTurnStart,
-			}
-			h.transcript.Segments = append(h.transcript.Segments, userSegment)
-		}
+		h.flushTurn()
+	}
+}
 
-		if h.currentBotContent != "" {
-			botSegment := SessionTranscriptSegment{
-				Role:      "ai",
-				Name:      h.meetingDetails.AgentName,
-				Content:   strings.TrimSpace(h.currentBotContent),
-				Timestamp: time.Now(),
-			}
-			h.transcript.Segments = append(h.transcript.Segments, botSegment)
+// flushTurn persists the accumulated turn and runs sentiment.
+func (h *GeminiRealtimeTextHandler) flushTurn() {
+	userSegments := h.userTurn.flush()
+	h.transcriptMu.Lock()
+	h.transcript.Segments = append(h.transcript.Segments, userSegments...)
+	if h.currentBotContent != "" {
+		botSegment := SessionTranscriptSegment{
+			Role:      "ai",
+			Name:      h.meetingDetails.AgentName,
+			Content:   strings.TrimSpace(h.currentBotContent),
+			Timestamp: time.Now(),
 		}
+		h.transcript.Segments = append(h.transcript.Segments, botSegment)
+	}
+	h.transcriptMu.Unlock()
+	h.inTurn.Store(false)
 
-		userMessage := h.currentUserContent
-		h.currentUserContent = ""
-		h.currentBotContent = ""
-		h.currentTurnStart = time.Now()
+	h.currentBotContent = ""
+	h.currentTurnStart = time.Now()
 
-		if userMessage != "" {
-			res, err := h.sentimentAnalyzer.Analyze(h.ctx, userMessage, h.userDetails.Name)
-			if err != nil {
-				fmt.Println("Error analyzing sentiment:", err)
-			} else {
-				h.cb.OnUserSentiment(res)
-			}
+	for _, segment := range userSegments {
+		res, err := h.sentimentAnalyzer.Analyze(h.ctx, segment.Content, segment.Name)
+		if err != nil {
+			fmt.Println("Error analyzing sentiment:", err)
+		} else {
+			h.cb.OnUserSentiment(res)
 		}
 	}
 }
 
+func (h *GeminiRealtimeTextHandler) speaker(from, to time.Time) Speaker {
+	return resolveSpeaker(h.cb, h.userDetails, from, to)
+}
+
+// GetTranscript returns a snapshot of the transcript so far.
 func (h *GeminiRealtimeTextHandler) GetTranscript() *SessionTranscript {
-	return h.transcript
+	h.transcriptMu.Lock()
+	defer h.transcriptMu.Unlock()
+	segments := make([]SessionTranscriptSegment, len(h.transcript.Segments))
+	copy(segments, h.transcript.Segments)
+	return &SessionTranscript{
+		Segments: segments,
+	}
 }
 
 func (h *GeminiRealtimeTextHandler) Close() error {
 	h.cancel()
-	return h.session.Close()
+	return h.conn.close()
 }
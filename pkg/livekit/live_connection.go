@@ -0,0 +1,318 @@
+package livekit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/rahulSailesh-shah/converSense/pkg/config"
+	"google.golang.org/genai"
+)
+
+const (
+	maxReconnectAttempts = 6
+	reconnectBaseDelay   = 500 * time.Millisecond
+	reconnectMaxDelay    = 8 * time.Second
+
+	// rolloverCheckInterval is how often a session due for rollover is
+	// checked for a quiet moment to switch.
+	rolloverCheckInterval = 5 * time.Second
+)
+
+var ErrModelReconnecting = errors.New("realtime model is reconnecting")
+
+// Connection status values reported through OnConnectionStatus.
+const (
+	ConnectionStatusReconnecting = "reconnecting"
+	ConnectionStatusReconnected  = "reconnected"
+	ConnectionStatusDisconnected = "disconnected"
+)
+
+// ConnectionStatusEvent is streamed to the room whenever the model connection
+// drops or recovers.
+type ConnectionStatusEvent struct {
+	Status  string `json:"status"`
+	Attempt int    `json:"attempt,omitempty"`
+}
+
+type liveConnectionHooks struct {
+	// onMessage handles every message read from the session.
+	onMessage func(response *genai.LiveServerMessage)
+	// onDrop runs before reconnecting so in-flight turns can be flushed.
+	onDrop func()
+	// onReconnect runs once a replacement session is up. resumed reports
+	// whether a resumption handle was offered to restore the conversation.
+	onReconnect func(resumed bool)
+	// instructions returns the system instructions for a session that starts
+	// without the previous conversation, e.g. seeded with a summary of it.
+	instructions func() string
+	// busy reports whether a turn is in progress, which postpones rollover.
+	busy func() bool
+}
+
+// liveConnection owns a Gemini Live session and re-establishes it when the
+// connection drops or the server sends GoAway, resuming the server-side
+// conversation from the latest session resumption handle. Long meetings are
+// additionally rolled over to a fresh session every rolloverAfter so the
+// model never runs into its session limits.
+type liveConnection struct {
+	client        *genai.Client
+	model         string
+	config        genai.LiveConnectConfig
+	ctx           context.Context
+	cb            *GeminiRealtimeAPIHandlerCallbacks
+	hooks         liveConnectionHooks
+	rolloverAfter time.Duration
+
+	// switchMu serialises reconnects and rollovers.
+	switchMu     sync.Mutex
+	mu           sync.RWMutex
+	session      *genai.Session
+	handle       string
+	reconnecting bool
+	connectedAt  time.Time
+}
+
+func newLiveConnection(ctx context.Context, client *genai.Client, model string,
+	config *genai.LiveConnectConfig, rolloverAfter time.Duration,
+	cb *GeminiRealtimeAPIHandlerCallbacks) (*liveConnection, error) {
+	c := &liveConnection{
+		client:        client,
+		model:         model,
+		config:        *config,
+		ctx:           ctx,
+		cb:            cb,
+		rolloverAfter: rolloverAfter,
+	}
+
+	session, err := c.connect("")
+	if err != nil {
+		return nil, err
+	}
+	c.session = session
+	c.connectedAt = time.Now()
+	return c, nil
+}
+
+func (c *liveConnection) connect(handle string) (*genai.Session, error) {
+	config := c.config
+	config.SessionResumption = &genai.SessionResumptionConfig{Handle: handle}
+	if handle == "" && c.hooks.instructions != nil {
+		config.SystemInstruction = genai.NewContentFromText(c.hooks.instructions(), genai.RoleUser)
+	}
+	return c.client.Live.Connect(c.ctx, c.model, &config)
+}
+
+// contextWindowCompression builds the sliding-window compression config, or
+// nil when compression is disabled.
+func contextWindowCompression(cfg *config.GeminiConfig) *genai.ContextWindowCompressionConfig {
+	if cfg.CompressionTriggerTokens <= 0 {
+		return nil
+	}
+	trigger := cfg.CompressionTriggerTokens
+	window := &genai.SlidingWindow{}
+	if cfg.CompressionTargetTokens > 0 {
+		target := cfg.CompressionTargetTokens
+		window.TargetTokens = &target
+	}
+	return &genai.ContextWindowCompressionConfig{
+		TriggerTokens: &trigger,
+		SlidingWindow: window,
+	}
+}
+
+// run reads from the session until the context is cancelled or the
+// connection cannot be re-established.
+func (c *liveConnection) run(hooks liveConnectionHooks) {
+	c.hooks = hooks
+	if c.rolloverAfter > 0 {
+		go c.rolloverLoop()
+	}
+	for {
+		session := c.current()
+		response, err := session.Receive()
+		if err != nil {
+			if c.ctx.Err() != nil {
+				fmt.Println("[-] Session closed")
+				return
+			}
+			if session != c.current() {
+				// Rolled over to a new session; the old one was closed on purpose.
+				continue
+			}
+			fmt.Println("[-] Error receiving message:", err)
+			if !c.reconnect() {
+				return
+			}
+			continue
+		}
+
+		if update := response.SessionResumptionUpdate; update != nil && update.Resumable && update.NewHandle != "" {
+			c.mu.Lock()
+			c.handle = update.NewHandle
+			c.mu.Unlock()
+		}
+
+		if response.GoAway != nil {
+			fmt.Println("[-] Gemini session going away, reconnecting", "timeLeft", response.GoAway.TimeLeft)
+			if !c.reconnect() {
+				return
+			}
+			continue
+		}
+
+		if c.hooks.onMessage != nil {
+			c.hooks.onMessage(response)
+		}
+	}
+}
+
+// reconnect replaces the current session, retrying with exponential backoff.
+// It reports false when the context was cancelled or every attempt failed.
+func (c *liveConnection) reconnect() bool {
+	c.switchMu.Lock()
+	defer c.switchMu.Unlock()
+
+	c.mu.Lock()
+	c.reconnecting = true
+	old := c.session
+	c.mu.Unlock()
+	old.Close()
+
+	if c.hooks.onDrop != nil {
+		c.hooks.onDrop()
+	}
+
+	delay := reconnectBaseDelay
+	for attempt := 1; attempt <= maxReconnectAttempts; attempt++ {
+		c.notify(ConnectionStatusEvent{Status: ConnectionStatusReconnecting, Attempt: attempt})
+
+		select {
+		case <-c.ctx.Done():
+			return false
+		case <-time.After(delay):
+		}
+
+		c.mu.RLock()
+		handle := c.handle
+		c.mu.RUnlock()
+
+		session, err := c.connect(handle)
+		if err != nil && handle != "" {
+			// The handle may have expired; fall back to a fresh session.
+			fmt.Println("[-] Failed to resume Gemini session, starting a new one:", err)
+			handle = ""
+			session, err = c.connect("")
+		}
+		if err == nil {
+			c.mu.Lock()
+			c.session = session
+			c.reconnecting = false
+			c.connectedAt = time.Now()
+			if handle == "" {
+				c.handle = ""
+			}
+			c.mu.Unlock()
+
+			fmt.Println("[-] Reconnected to Gemini", "attempt", attempt, "resumed", handle != "")
+			if c.hooks.onReconnect != nil {
+				c.hooks.onReconnect(handle != "")
+			}
+			c.notify(ConnectionStatusEvent{Status: ConnectionStatusReconnected, Attempt: attempt})
+			return true
+		}
+
+		fmt.Printf("[ERROR] Reconnect attempt %d failed: %v\n", attempt, err)
+		delay = min(delay*2, reconnectMaxDelay)
+	}
+
+	c.notify(ConnectionStatusEvent{Status: ConnectionStatusDisconnected})
+	return false
+}
+
+func (c *liveConnection) rolloverLoop() {
+	ticker := time.NewTicker(rolloverCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		c.mu.RLock()
+		due := time.Since(c.connectedAt) >= c.rolloverAfter
+		c.mu.RUnlock()
+		if !due || (c.hooks.busy != nil && c.hooks.busy()) {
+			continue
+		}
+		if err := c.rollover(); err != nil {
+			fmt.Printf("[ERROR] Failed to roll over Gemini session: %v\n", err)
+		}
+	}
+}
+
+// rollover opens a fresh session seeded through hooks.instructions and swaps
+// it in before closing the old one, so the room never loses the agent.
+func (c *liveConnection) rollover() error {
+	if !c.switchMu.TryLock() {
+		// A reconnect is in progress and will produce a new session anyway.
+		return nil
+	}
+	defer c.switchMu.Unlock()
+
+	session, err := c.connect("")
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	old := c.session
+	c.session = session
+	c.handle = ""
+	c.connectedAt = time.Now()
+	c.mu.Unlock()
+	old.Close()
+
+	fmt.Println("[-] Rolled over to a fresh Gemini session")
+	if c.hooks.onReconnect != nil {
+		c.hooks.onReconnect(false)
+	}
+	return nil
+}
+
+func (c *liveConnection) current() *genai.Session {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.session
+}
+
+func (c *liveConnection) sendRealtimeInput(input genai.LiveRealtimeInput) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.reconnecting {
+		return ErrModelReconnecting
+	}
+	return c.session.SendRealtimeInput(input)
+}
+
+func (c *liveConnection) sendToolResponse(input genai.LiveToolResponseInput) error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.reconnecting {
+		return ErrModelReconnecting
+	}
+	return c.session.SendToolResponse(input)
+}
+
+func (c *liveConnection) notify(event ConnectionStatusEvent) {
+	if c.cb != nil && c.cb.OnConnectionStatus != nil {
+		c.cb.OnConnectionStatus(&event)
+	}
+}
+
+func (c *liveConnection) close() error {
+	return c.current().Close()
+}
@@ -0,0 +1,99 @@
+package livekit
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/livekit/media-sdk"
+	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+	"github.com/rahulSailesh-shah/converSense/pkg/agentconfig"
+	"github.com/rahulSailesh-shah/converSense/pkg/config"
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
+	sentimentanalyzer "github.com/rahulSailesh-shah/converSense/pkg/sentiment-analyzer"
+)
+
+// RealtimeModel is the speech-to-speech (or speech-to-text) model that sits
+// behind a LiveKitSession. Audio captured from the room is pushed in through
+// SendAudioChunk; anything the model produces is surfaced via the callbacks
+// supplied at construction time.
+type RealtimeModel interface {
+	SendAudioChunk(sample media.PCM16Sample) error
+	SendText(text string) error
+	GetTranscript() *SessionTranscript
+	Close() error
+}
+
+type RealtimeModelType string
+
+const (
+	RealtimeModelGeminiAudio RealtimeModelType = "gemini-audio"
+	RealtimeModelGeminiText  RealtimeModelType = "gemini-text"
+)
+
+const DefaultRealtimeModel = RealtimeModelGeminiAudio
+
+// RealtimeModelOptions carries everything a provider needs to open a session.
+type RealtimeModelOptions struct {
+	GeminiConfig      *config.GeminiConfig
+	UserDetails       *repo.User
+	MeetingDetails    *repo.GetMeetingRow
+	Callbacks         *GeminiRealtimeAPIHandlerCallbacks
+	SentimentAnalyzer sentimentanalyzer.SentimentAnalyzer
+	Tools             *ToolRegistry
+	Settings          agentconfig.Settings
+	SummaryModel      *llm.Model // keeps the summary that seeds a fresh session
+}
+
+type RealtimeModelFactory func(ctx context.Context, opts RealtimeModelOptions) (RealtimeModel, error)
+
+var (
+	realtimeModelsMu sync.RWMutex
+	realtimeModels   = map[RealtimeModelType]RealtimeModelFactory{
+		RealtimeModelGeminiAudio: func(ctx context.Context, opts RealtimeModelOptions) (RealtimeModel, error) {
+			return NewGeminiRealtimeAPIHandler(ctx, opts.GeminiConfig, opts.UserDetails, opts.MeetingDetails,
+				opts.Callbacks, opts.Tools, opts.Settings, opts.SentimentAnalyzer, opts.SummaryModel)
+		},
+		RealtimeModelGeminiText: func(ctx context.Context, opts RealtimeModelOptions) (RealtimeModel, error) {
+			return NewGeminiRealtimeTextHandler(ctx, opts.GeminiConfig, opts.UserDetails, opts.MeetingDetails,
+				opts.Callbacks, opts.Tools, opts.Settings, opts.SentimentAnalyzer, opts.SummaryModel)
+		},
+	}
+)
+
+// RegisterRealtimeModel makes a provider available under the given name.
+// Registering an existing name replaces the previous factory.
+func RegisterRealtimeModel(modelType RealtimeModelType, factory RealtimeModelFactory) {
+	realtimeModelsMu.Lock()
+	defer realtimeModelsMu.Unlock()
+	realtimeModels[modelType] = factory
+}
+
+// RealtimeModelTypes lists the registered provider names in sorted order.
+func RealtimeModelTypes() []RealtimeModelType {
+	realtimeModelsMu.RLock()
+	defer realtimeModelsMu.RUnlock()
+	types := make([]RealtimeModelType, 0, len(realtimeModels))
+	for t := range realtimeModels {
+		types = append(types, t)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// NewRealtimeModel opens a session with the provider registered under
+// modelType. An empty modelType selects DefaultRealtimeModel.
+func NewRealtimeModel(ctx context.Context, modelType RealtimeModelType, opts RealtimeModelOptions) (RealtimeModel, error) {
+	if modelType == "" {
+		modelType = DefaultRealtimeModel
+	}
+
+	realtimeModelsMu.RLock()
+	factory, ok := realtimeModels[modelType]
+	realtimeModelsMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown realtime model: %s", modelType)
+	}
+	return factory(ctx, opts)
+}
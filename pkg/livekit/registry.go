@@ -0,0 +1,122 @@
+package livekit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/livekit/protocol/livekit"
+	lksdk "github.com/livekit/server-sdk-go/v2"
+	"github.com/rahulSailesh-shah/converSense/pkg/config"
+)
+
+// SessionRegistry tracks the live sessions owned by this process, keyed by
+// meeting ID.
+type SessionRegistry struct {
+	mu       sync.RWMutex
+	sessions map[string]*LiveKitSession
+	closed   bool
+}
+
+func NewSessionRegistry() *SessionRegistry {
+	return &SessionRegistry{
+		sessions: make(map[string]*LiveKitSession),
+	}
+}
+
+// Add registers a session. It fails if the meeting already has one, which
+// guards against the same meeting being started twice concurrently.
+func (r *SessionRegistry) Add(session *LiveKitSession) error {
+	meetingID := session.MeetingID()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return fmt.Errorf("server is shutting down")
+	}
+	if _, exists := r.sessions[meetingID]; exists {
+		return fmt.Errorf("meeting %s already has a live session", meetingID)
+	}
+	r.sessions[meetingID] = session
+	return nil
+}
+
+func (r *SessionRegistry) Get(meetingID string) (*LiveKitSession, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	session, ok := r.sessions[meetingID]
+	return session, ok
+}
+
+func (r *SessionRegistry) Remove(meetingID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.sessions, meetingID)
+}
+
+func (r *SessionRegistry) List() []*LiveKitSession {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	sessions := make([]*LiveKitSession, 0, len(r.sessions))
+	for _, session := range r.sessions {
+		sessions = append(sessions, session)
+	}
+	return sessions
+}
+
+// Close stops the registry from accepting new sessions and returns the ones
+// still live so they can be drained.
+func (r *SessionRegistry) Close() []*LiveKitSession {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.closed = true
+	sessions := make([]*LiveKitSession, 0, len(r.sessions))
+	for _, session := range r.sessions {
+		sessions = append(sessions, session)
+	}
+	return sessions
+}
+
+// CleanupOrphanedRoom stops any egress still recording a room whose session
+// was lost (e.g. after a restart) and closes the room. It returns the
+// recording URL when a recording was finalised.
+func CleanupOrphanedRoom(ctx context.Context, lkConfig *config.LiveKitConfig, awsConfig *config.AWSConfig,
+	userID string, meetingID string) (string, error) {
+	egressClient := lksdk.NewEgressClient(
+		lkConfig.Host,
+		lkConfig.APIKey,
+		lkConfig.APISecret,
+	)
+
+	res, err := egressClient.ListEgress(ctx, &livekit.ListEgressRequest{
+		RoomName: meetingID,
+		Active:   true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to list egress: %w", err)
+	}
+
+	var recordingURL string
+	for _, info := range res.Items {
+		if _, err := egressClient.StopEgress(ctx, &livekit.StopEgressRequest{
+			EgressId: info.EgressId,
+		}); err != nil {
+			return "", fmt.Errorf("failed to stop egress %s: %w", info.EgressId, err)
+		}
+		recordingURL = recordingS3URL(awsConfig, userID, meetingID)
+	}
+
+	roomClient := lksdk.NewRoomServiceClient(
+		lkConfig.Host,
+		lkConfig.APIKey,
+		lkConfig.APISecret,
+	)
+	if _, err := roomClient.DeleteRoom(ctx, &livekit.DeleteRoomRequest{
+		Room: meetingID,
+	}); err != nil {
+		// The room has usually been closed already by LiveKit's empty timeout.
+		fmt.Printf("[-] Failed to delete room %s: %v\n", meetingID, err)
+	}
+
+	return recordingURL, nil
+}
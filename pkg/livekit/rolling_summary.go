@@ -0,0 +1,71 @@
+package livekit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
+)
+
+// rollingSummary keeps a running summary of the meeting so a fresh realtime
+// session can pick up where the previous one left off. Each update only
+// sends the segments added since the last one, folded into the previous
+// summary.
+type rollingSummary struct {
+	model *llm.Model
+
+	mu         sync.Mutex
+	summary    string
+	summarized int // number of transcript segments already folded in
+}
+
+func newRollingSummary(model *llm.Model) *rollingSummary {
+	return &rollingSummary{model: model}
+}
+
+// update folds any new segments into the summary and returns it. On failure
+// the previous summary is returned alongside the error.
+func (r *rollingSummary) update(ctx context.Context, segments []SessionTranscriptSegment) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.summarized >= len(segments) {
+		return r.summary, nil
+	}
+
+	var newText strings.Builder
+	for _, segment := range segments[r.summarized:] {
+		newText.WriteString(fmt.Sprintf("[%s] %s: %s\n", segment.Timestamp.Format("15:04:05"), segment.Name, segment.Content))
+	}
+
+	prompt := fmt.Sprintf(`
+        You maintain a running summary of a live meeting so an assistant can continue the conversation after its memory is reset.
+        Merge the new transcript lines into the existing summary. Keep who said what, decisions, open questions, commitments and anything the assistant promised to do.
+        Be concise and write plain prose, no more than 300 words.
+
+        Existing summary:
+        %s
+
+        New transcript lines:
+        %s`, r.summary, newText.String())
+
+	summary, err := r.model.Complete(ctx, llm.User(prompt))
+	if err != nil {
+		return r.summary, fmt.Errorf("failed to generate rolling summary: %w", err)
+	}
+
+	r.summary = strings.TrimSpace(summary)
+	r.summarized = len(segments)
+	return r.summary, nil
+}
+
+// seededInstructions appends the meeting summary to the agent's instructions
+// for a session that starts without the previous conversation.
+func seededInstructions(instructions string, summary string) string {
+	if summary == "" {
+		return instructions
+	}
+	return instructions + "\n\nYou are rejoining a meeting that is already in progress. Summary of the conversation so far:\n" + summary
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/credentials"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/livekit/media-sdk"
+	"github.com/livekit/media-sdk/mixer"
 	"github.com/livekit/protocol/auth"
 	"github.com/livekit/protocol/livekit"
 	"github.com/livekit/protocol/logger"
@@ -19,8 +20,11 @@ import (
 	lkmedia "github.com/livekit/server-sdk-go/v2/pkg/media"
 	"github.com/pion/webrtc/v4"
 	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+	"github.com/rahulSailesh-shah/converSense/pkg/agentconfig"
 	"github.com/rahulSailesh-shah/converSense/pkg/config"
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
 	sentimentanalyzer "github.com/rahulSailesh-shah/converSense/pkg/sentiment-analyzer"
+	"go.uber.org/atomic"
 )
 
 type SessionCallbacks struct {
@@ -32,15 +36,31 @@ type StreamTextData struct {
 	Data interface{} `json:"data"`
 }
 
+// SessionHealth is a point-in-time snapshot of a live session.
+type SessionHealth struct {
+	MeetingID       string
+	ModelType       RealtimeModelType
+	ConnectionState string
+	Participants    int
+	Recording       bool
+	Stopped         bool
+	StartedAt       time.Time
+}
+
 type LiveKitSession struct {
 	meetingDetails  *repo.GetMeetingRow
 	userDetails     *repo.User
 	room            *lksdk.Room
-	handler         *GeminiRealtimeAPIHandler
+	handler         RealtimeModel
+	modelType       RealtimeModelType
+	tools           *ToolRegistry
+	settings        agentconfig.Settings
 	egressInfo      *livekit.EgressInfo
 	lkConfig        *config.LiveKitConfig
 	geminiConfig    *config.GeminiConfig
 	awsConfig       *config.AWSConfig
+	sentimentModel  *llm.Model
+	summaryModel    *llm.Model
 	ctx             context.Context
 	cancel          context.CancelFunc
 	callbacks       SessionCallbacks
@@ -48,6 +68,26 @@ type LiveKitSession struct {
 	transcriptURL   string
 	stopOnce        sync.Once
 	textStreamQueue chan StreamTextData
+	startedAt       time.Time
+	ready           atomic.Bool // set once Start has finished wiring the room
+	stopped         atomic.Bool
+
+	// queueMu guards closing textStreamQueue against late sends from handler
+	// callbacks and tool goroutines
+	queueMu     sync.RWMutex
+	queueClosed bool
+
+	// Every human participant's audio is mixed into a single model input.
+	mixer        *mixer.Mixer
+	speakers     *SpeakerTracker
+	tracksMu     sync.Mutex
+	remoteTracks map[string]remoteTrack // keyed by track SID
+}
+
+// remoteTrack is a subscribed participant audio track and who publishes it.
+type remoteTrack struct {
+	pcm      *lkmedia.PCMRemoteTrack
+	identity string
 }
 
 func NewLiveKitSession(
@@ -56,6 +96,11 @@ func NewLiveKitSession(
 	lkConfig *config.LiveKitConfig,
 	geminiConfig *config.GeminiConfig,
 	awsConfig *config.AWSConfig,
+	sentimentModel *llm.Model,
+	summaryModel *llm.Model,
+	modelType RealtimeModelType,
+	tools *ToolRegistry,
+	settings agentconfig.Settings,
 	callbacks SessionCallbacks,
 ) *LiveKitSession {
 	ctx, cancel := context.WithCancel(context.Background())
@@ -66,11 +111,18 @@ func NewLiveKitSession(
 		lkConfig:        lkConfig,
 		geminiConfig:    geminiConfig,
 		awsConfig:       awsConfig,
+		sentimentModel:  sentimentModel,
+		summaryModel:    summaryModel,
+		modelType:       modelType,
+		tools:           tools,
+		settings:        settings,
 		ctx:             ctx,
 		cancel:          cancel,
 		callbacks:       callbacks,
 		stopOnce:        sync.Once{},
 		textStreamQueue: make(chan StreamTextData, 100),
+		speakers:        NewSpeakerTracker(),
+		remoteTracks:    make(map[string]remoteTrack),
 	}
 }
 
@@ -78,28 +130,69 @@ func (s *LiveKitSession) Start() error {
 	if err := s.connectBot(); err != nil {
 		return fmt.Errorf("failed to connect bot: %w", err)
 	}
+	s.startedAt = time.Now()
+	s.ready.Store(true)
 	return nil
 }
 
+func (s *LiveKitSession) MeetingID() string {
+	return s.meetingDetails.ID.String()
+}
+
+func (s *LiveKitSession) Health() SessionHealth {
+	health := SessionHealth{
+		MeetingID:       s.MeetingID(),
+		ModelType:       s.modelType,
+		ConnectionState: "connecting",
+		Stopped:         s.stopped.Load(),
+	}
+	if health.ModelType == "" {
+		health.ModelType = DefaultRealtimeModel
+	}
+	if !s.ready.Load() {
+		return health
+	}
+	health.StartedAt = s.startedAt
+	health.Recording = s.egressInfo != nil
+	if s.room != nil {
+		health.ConnectionState = string(s.room.ConnectionState())
+		health.Participants = s.humanParticipantCount()
+	}
+	return health
+}
+
 func (s *LiveKitSession) Stop() error {
+	return s.StopWithContext(context.Background())
+}
+
+// StopWithContext ends the session, bounding the egress and transcript upload
+// calls by ctx. The transcript is uploaded even when stopping the recording
+// fails so a partial meeting can still be post-processed.
+func (s *LiveKitSession) StopWithContext(ctx context.Context) error {
 	var stopErr error
 	meetingId := s.meetingDetails.ID.String()
 	s.stopOnce.Do(func() {
+		s.stopped.Store(true)
 		s.cancel()
 		if s.egressInfo != nil {
-			if err := s.stopRecording(s.egressInfo.EgressId); err != nil {
+			if err := s.stopRecording(ctx, s.egressInfo.EgressId); err != nil {
 				stopErr = fmt.Errorf("failed to stop recording: %w", err)
 			}
 		}
-		if s.textStreamQueue != nil {
-			close(s.textStreamQueue)
+		if err := s.uploadTranscript(ctx); err != nil {
+			logger.Warnw("Failed to upload transcript", err, "meetingID", meetingId)
 		}
 		if s.room != nil {
 			s.room.Disconnect()
 		}
+		s.closeRemoteTracks()
+		if s.mixer != nil {
+			s.mixer.Stop()
+		}
 		if s.handler != nil {
 			s.handler.Close()
 		}
+		s.closeTextQueue()
 		if s.callbacks.OnMeetingEnd != nil {
 			s.callbacks.OnMeetingEnd(meetingId, s.recordingURL, s.transcriptURL, stopErr)
 		}
@@ -107,14 +200,39 @@ func (s *LiveKitSession) Stop() error {
 	return stopErr
 }
 
+// ParticipantMetadata is attached to every participant token so clients can
+// render who is who without an extra lookup.
+type ParticipantMetadata struct {
+	UserID string `json:"userId"`
+	Name   string `json:"name"`
+	Role   string `json:"role"` // "owner" or "participant"
+}
+
 func (s *LiveKitSession) GenerateUserToken() (string, error) {
-	at := auth.NewAccessToken(s.lkConfig.APIKey, s.lkConfig.APISecret)
+	return GenerateParticipantToken(s.lkConfig, s.meetingDetails.ID.String(), ParticipantMetadata{
+		UserID: s.userDetails.ID,
+		Name:   s.userDetails.Name,
+		Role:   "owner",
+	})
+}
+
+// GenerateParticipantToken mints a room-scoped join token whose identity is
+// the user ID, with the display name carried in the participant metadata.
+func GenerateParticipantToken(lkConfig *config.LiveKitConfig, roomName string, metadata ParticipantMetadata) (string, error) {
+	metadataJSON, err := json.Marshal(metadata)
+	if err != nil {
+		return "", err
+	}
+
+	at := auth.NewAccessToken(lkConfig.APIKey, lkConfig.APISecret)
 	grant := &auth.VideoGrant{
 		RoomJoin: true,
-		Room:     s.meetingDetails.ID.String(),
+		Room:     roomName,
 	}
 	at.SetVideoGrant(grant).
-		SetIdentity(s.userDetails.Name).
+		SetIdentity(metadata.UserID).
+		SetName(metadata.Name).
+		SetMetadata(string(metadataJSON)).
 		SetValidFor(time.Hour)
 	token, err := at.ToJWT()
 	if err != nil {
@@ -124,17 +242,18 @@ func (s *LiveKitSession) GenerateUserToken() (string, error) {
 }
 
 func (s *LiveKitSession) connectBot() error {
-	sentimentAnalyzer, err := sentimentanalyzer.NewSentimentAnalyzer(sentimentanalyzer.AnalyzerTypeOllama)
+	sentimentAnalyzer, err := sentimentanalyzer.NewSentimentAnalyzer(s.sentimentModel)
 	if err != nil {
 		logger.Errorw("Failed to create sentiment analyzer", err, "meetingID", s.meetingDetails.ID.String())
 		return fmt.Errorf("failed to create sentiment analyzer: %w", err)
 	}
 	audioWriterChan := make(chan media.PCM16Sample, 500)
-	handler, err := NewGeminiRealtimeAPIHandler(s.ctx,
-		s.geminiConfig,
-		s.userDetails,
-		s.meetingDetails,
-		&GeminiRealtimeAPIHandlerCallbacks{
+	handler, err := NewRealtimeModel(s.ctx, s.modelType, RealtimeModelOptions{
+		GeminiConfig:   s.geminiConfig,
+		UserDetails:    s.userDetails,
+		MeetingDetails: s.meetingDetails,
+		SummaryModel:   s.summaryModel,
+		Callbacks: &GeminiRealtimeAPIHandlerCallbacks{
 			OnAudioReceived: func(audio media.PCM16Sample) {
 				select {
 				case audioWriterChan <- audio:
@@ -145,35 +264,51 @@ func (s *LiveKitSession) connectBot() error {
 				}
 			},
 			OnUserSentiment: func(result *sentimentanalyzer.SentimentResult) {
-				streamTextData := StreamTextData{
+				s.queueText(StreamTextData{
 					Type: "sentiment",
 					Data: result,
-				}
-				select {
-				case s.textStreamQueue <- streamTextData:
-				default:
-					logger.Warnw("Text stream queue full, dropping sentiment message", nil)
-				}
+				})
+			},
+			OnConnectionStatus: func(event *ConnectionStatusEvent) {
+				s.queueText(StreamTextData{
+					Type: "connection",
+					Data: event,
+				})
+			},
+			OnToolResult: func(result *ToolResultEvent) {
+				s.queueText(StreamTextData{
+					Type: "tool",
+					Data: result,
+				})
 			},
+			ResolveSpeaker: s.speakers.Between,
 			OnUserTranscript: func(result *TranscriptDataStream) {
-				streamTextData := StreamTextData{
+				s.queueText(StreamTextData{
 					Type: "transcript",
 					Data: result,
-				}
-				select {
-				case s.textStreamQueue <- streamTextData:
-				default:
-					logger.Warnw("Text stream queue full, dropping transcript message", nil)
-				}
+				})
 			},
-		}, sentimentAnalyzer)
+		},
+		SentimentAnalyzer: sentimentAnalyzer,
+		Tools:             s.tools,
+		Settings:          s.settings,
+	})
 	if err != nil {
 		close(audioWriterChan)
-		return fmt.Errorf("failed to create Gemini handler: %w", err)
+		return fmt.Errorf("failed to create realtime model %q: %w", s.modelType, err)
 	}
 	s.handler = handler
 
+	audioMixer, err := mixer.NewMixer(NewModelWriter(handler), 20*time.Millisecond, nil, 1, mixer.DefaultInputBufferFrames)
+	if err != nil {
+		s.handler.Close()
+		close(audioWriterChan)
+		return fmt.Errorf("failed to create audio mixer: %w", err)
+	}
+	s.mixer = audioMixer
+
 	if err := s.connectToRoom(); err != nil {
+		s.mixer.Stop()
 		s.handler.Close()
 		close(audioWriterChan)
 		return fmt.Errorf("failed to connect to room: %w", err)
@@ -206,36 +341,102 @@ func (s *LiveKitSession) connectToRoom() error {
 }
 
 func (s *LiveKitSession) callbacksForRoom() *lksdk.RoomCallback {
-	var pcmRemoteTrack *lkmedia.PCMRemoteTrack
-
 	return &lksdk.RoomCallback{
 		ParticipantCallback: lksdk.ParticipantCallback{
 			OnTrackSubscribed: func(track *webrtc.TrackRemote, publication *lksdk.RemoteTrackPublication,
 				rp *lksdk.RemoteParticipant) {
-				if pcmRemoteTrack != nil {
+				if track.Kind() != webrtc.RTPCodecTypeAudio || !isHumanParticipant(rp) {
 					return
 				}
-				pcmRemoteTrack, _ = s.handleSubscribe(track)
+				pcmRemoteTrack, err := s.handleSubscribe(track, Speaker{
+					Identity: rp.Identity(),
+					Name:     participantDisplayName(rp),
+				})
+				if err != nil {
+					return
+				}
+				s.tracksMu.Lock()
+				s.remoteTracks[publication.SID()] = remoteTrack{pcm: pcmRemoteTrack, identity: rp.Identity()}
+				s.tracksMu.Unlock()
+			},
+			OnTrackUnsubscribed: func(track *webrtc.TrackRemote, publication *lksdk.RemoteTrackPublication,
+				rp *lksdk.RemoteParticipant) {
+				s.tracksMu.Lock()
+				removed, ok := s.remoteTracks[publication.SID()]
+				delete(s.remoteTracks, publication.SID())
+				// A participant may publish more than one audio track (e.g.
+				// microphone and screen share); keep them in the speaker
+				// comparison until the last one goes away.
+				remaining := 0
+				for _, t := range s.remoteTracks {
+					if t.identity == rp.Identity() {
+						remaining++
+					}
+				}
+				s.tracksMu.Unlock()
+				if ok {
+					removed.pcm.Close()
+				}
+				if remaining == 0 {
+					s.speakers.Remove(rp.Identity())
+				}
 			},
 		},
 		OnParticipantDisconnected: func(participant *lksdk.RemoteParticipant) {
-			s.Stop()
+			if !isHumanParticipant(participant) {
+				return
+			}
+			if s.humanParticipantCount() == 0 {
+				logger.Infow("Last participant left, ending meeting", "meetingID", s.meetingDetails.ID.String())
+				s.Stop()
+			}
 		},
 		OnDisconnected: func() {
-			if pcmRemoteTrack != nil {
-				pcmRemoteTrack.Close()
-				pcmRemoteTrack = nil
-			}
+			s.closeRemoteTracks()
 		},
 		OnDisconnectedWithReason: func(reason lksdk.DisconnectionReason) {
-			if pcmRemoteTrack != nil {
-				pcmRemoteTrack.Close()
-				pcmRemoteTrack = nil
-			}
+			s.closeRemoteTracks()
 		},
 	}
 }
 
+func (s *LiveKitSession) closeRemoteTracks() {
+	s.tracksMu.Lock()
+	tracks := s.remoteTracks
+	s.remoteTracks = make(map[string]remoteTrack)
+	s.tracksMu.Unlock()
+
+	for _, track := range tracks {
+		track.pcm.Close()
+	}
+}
+
+func (s *LiveKitSession) humanParticipantCount() int {
+	if s.room == nil {
+		return 0
+	}
+	count := 0
+	for _, p := range s.room.GetRemoteParticipants() {
+		if isHumanParticipant(p) {
+			count++
+		}
+	}
+	return count
+}
+
+// isHumanParticipant filters out egress recorders and other agents so they
+// neither feed the model nor keep the meeting alive.
+func isHumanParticipant(p lksdk.Participant) bool {
+	return p.Kind() == lksdk.ParticipantStandard || p.Kind() == lksdk.ParticipantSIP
+}
+
+func participantDisplayName(p lksdk.Participant) string {
+	if p.Name() != "" {
+		return p.Name()
+	}
+	return p.Identity()
+}
+
 func (s *LiveKitSession) handlePublish(audioWriterChan chan media.PCM16Sample) {
 	publishTrack, err := lkmedia.NewPCMLocalTrack(24000, 1, logger.GetLogger())
 	if err != nil {
@@ -270,6 +471,31 @@ func (s *LiveKitSession) handlePublish(audioWriterChan chan media.PCM16Sample) {
 	}
 }
 
+// queueText hands a message to the text stream worker. It is dropped when
+// the queue is full or the session has stopped.
+func (s *LiveKitSession) queueText(data StreamTextData) {
+	s.queueMu.RLock()
+	defer s.queueMu.RUnlock()
+	if s.queueClosed {
+		return
+	}
+	select {
+	case s.textStreamQueue <- data:
+	default:
+		logger.Warnw("Text stream queue full, dropping message", nil, "type", data.Type)
+	}
+}
+
+func (s *LiveKitSession) closeTextQueue() {
+	s.queueMu.Lock()
+	defer s.queueMu.Unlock()
+	if s.queueClosed || s.textStreamQueue == nil {
+		return
+	}
+	s.queueClosed = true
+	close(s.textStreamQueue)
+}
+
 func (s *LiveKitSession) handleTextStreamQueue() {
 	for {
 		select {
@@ -292,14 +518,19 @@ func (s *LiveKitSession) handleTextStreamQueue() {
 	}
 }
 
-func (s *LiveKitSession) handleSubscribe(track *webrtc.TrackRemote) (*lkmedia.PCMRemoteTrack, error) {
+func (s *LiveKitSession) handleSubscribe(track *webrtc.TrackRemote, speaker Speaker) (*lkmedia.PCMRemoteTrack, error) {
 	if track.Codec().MimeType != webrtc.MimeTypeOpus {
 		logger.Warnw("Received non-opus track", nil, "track", track.Codec().MimeType)
 	}
 
-	writer := NewRemoteTrackWriter(s.handler)
-	trackWriter, err := lkmedia.NewPCMRemoteTrack(track, writer, lkmedia.WithTargetSampleRate(16000))
+	input := s.mixer.NewInput()
+	if input == nil {
+		return nil, ErrClosed
+	}
+	writer := NewRemoteTrackWriter(speaker, input, s.speakers)
+	trackWriter, err := lkmedia.NewPCMRemoteTrack(track, writer, lkmedia.WithTargetSampleRate(modelInputSampleRate))
 	if err != nil {
+		writer.Close()
 		logger.Errorw("Failed to create remote track", err, "meetingID", s.meetingDetails.ID.String())
 		return nil, err
 	}
@@ -341,44 +572,55 @@ func (s *LiveKitSession) startRecording() (*livekit.EgressInfo, error) {
 	return res, nil
 }
 
-func (s *LiveKitSession) stopRecording(egressID string) error {
+func (s *LiveKitSession) stopRecording(ctx context.Context, egressID string) error {
 	egressClient := lksdk.NewEgressClient(
 		s.lkConfig.Host,
 		s.lkConfig.APIKey,
 		s.lkConfig.APISecret,
 	)
 
-	_, err := egressClient.StopEgress(context.Background(), &livekit.StopEgressRequest{
+	_, err := egressClient.StopEgress(ctx, &livekit.StopEgressRequest{
 		EgressId: egressID,
 	})
 	if err != nil {
 		return err
 	}
 
-	s.recordingURL = fmt.Sprintf("s3://%s/%s/%s/recording.mp4", s.awsConfig.Bucket, s.userDetails.ID,
-		s.meetingDetails.ID.String())
+	s.recordingURL = recordingS3URL(s.awsConfig, s.userDetails.ID, s.meetingDetails.ID.String())
+	return nil
+}
 
-	if s.handler != nil {
-		transcriptData := s.handler.GetTranscript()
-		jsonBytes, err := json.MarshalIndent(transcriptData, "", "  ")
-		if err == nil {
-			s3Key := fmt.Sprintf("%s/%s/transcript.json", s.userDetails.ID, s.meetingDetails.ID.String())
-			awsCfg := aws.Config{
-				Region:      s.awsConfig.Region,
-				Credentials: credentials.NewStaticCredentialsProvider(s.awsConfig.AccessKey, s.awsConfig.SecretKey, ""),
-			}
-			s3Client := s3.NewFromConfig(awsCfg)
+func (s *LiveKitSession) uploadTranscript(ctx context.Context) error {
+	if s.handler == nil {
+		return nil
+	}
 
-			_, err := s3Client.PutObject(context.Background(), &s3.PutObjectInput{
-				Bucket: &s.awsConfig.Bucket,
-				Key:    &s3Key,
-				Body:   bytes.NewReader(jsonBytes),
-			})
-			if err == nil {
-				s.transcriptURL = fmt.Sprintf("s3://%s/%s", s.awsConfig.Bucket, s3Key)
-			}
-		}
+	transcriptData := s.handler.GetTranscript()
+	jsonBytes, err := json.MarshalIndent(transcriptData, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	s3Key := fmt.Sprintf("%s/%s/transcript.json", s.userDetails.ID, s.meetingDetails.ID.String())
+	awsCfg := aws.Config{
+		Region:      s.awsConfig.Region,
+		Credentials: credentials.NewStaticCredentialsProvider(s.awsConfig.AccessKey, s.awsConfig.SecretKey, ""),
+	}
+	s3Client := s3.NewFromConfig(awsCfg)
+
+	_, err = s3Client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket: &s.awsConfig.Bucket,
+		Key:    &s3Key,
+		Body:   bytes.NewReader(jsonBytes),
+	})
+	if err != nil {
+		return err
 	}
 
+	s.transcriptURL = fmt.Sprintf("s3://%s/%s", s.awsConfig.Bucket, s3Key)
 	return nil
 }
+
+func recordingS3URL(awsConfig *config.AWSConfig, userID string, meetingID string) string {
+	return fmt.Sprintf("s3://%s/%s/%s/recording.mp4", awsConfig.Bucket, userID, meetingID)
+}
@@ -0,0 +1,229 @@
+package livekit
+
+import (
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/livekit/media-sdk"
+)
+
+const (
+	// speechEnergyThreshold is the RMS level (int16 scale) above which a
+	// frame is treated as speech rather than background noise.
+	speechEnergyThreshold = 500.0
+	// speakerLevelSmoothing weights the newest frame in the running level.
+	speakerLevelSmoothing = 0.3
+	// speakerLevelTTL drops participants whose track has gone quiet (DTX,
+	// mute) from the comparison.
+	speakerLevelTTL = 500 * time.Millisecond
+	// speechHistoryTTL bounds how far back utterances can be attributed.
+	speechHistoryTTL = 30 * time.Second
+	// maxUtteranceWindow caps the audio span a single transcription chunk
+	// is attributed over, so a chunk after a long silence is not matched
+	// against stale speech.
+	maxUtteranceWindow = 10 * time.Second
+)
+
+// Speaker identifies the room participant a piece of audio came from.
+type Speaker struct {
+	Identity string
+	Name     string
+}
+
+type speakerLevel struct {
+	speaker  Speaker
+	level    float64
+	lastSeen time.Time
+}
+
+// speechFrame is one frame of a participant's audio above the speech
+// threshold.
+type speechFrame struct {
+	speaker Speaker
+	energy  float64
+	at      time.Time
+}
+
+// SpeakerTracker attributes the mixed model input back to individual
+// participants by comparing the energy of each participant's track.
+type SpeakerTracker struct {
+	mu      sync.Mutex
+	levels  map[string]*speakerLevel
+	history []speechFrame
+	current Speaker
+}
+
+func NewSpeakerTracker() *SpeakerTracker {
+	return &SpeakerTracker{
+		levels: make(map[string]*speakerLevel),
+	}
+}
+
+// Observe records one frame of a participant's audio.
+func (t *SpeakerTracker) Observe(speaker Speaker, sample media.PCM16Sample) {
+	t.observe(speaker, sample, time.Now())
+}
+
+func (t *SpeakerTracker) observe(speaker Speaker, sample media.PCM16Sample, now time.Time) {
+	energy := rmsEnergy(sample)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	l, ok := t.levels[speaker.Identity]
+	if !ok {
+		l = &speakerLevel{speaker: speaker}
+		t.levels[speaker.Identity] = l
+	}
+	l.level = speakerLevelSmoothing*energy + (1-speakerLevelSmoothing)*l.level
+	l.lastSeen = now
+
+	if energy >= speechEnergyThreshold {
+		t.history = append(t.history, speechFrame{speaker: speaker, energy: energy, at: now})
+	}
+	cutoff := now.Add(-speechHistoryTTL)
+	stale := 0
+	for stale < len(t.history) && t.history[stale].at.Before(cutoff) {
+		stale++
+	}
+	if stale > 0 {
+		t.history = append(t.history[:0], t.history[stale:]...)
+	}
+
+	// The loudest participant above the speech threshold becomes the active
+	// speaker; otherwise the previous speaker is kept through pauses.
+	var loudest *speakerLevel
+	for _, candidate := range t.levels {
+		if now.Sub(candidate.lastSeen) > speakerLevelTTL || candidate.level < speechEnergyThreshold {
+			continue
+		}
+		if loudest == nil || candidate.level > loudest.level {
+			loudest = candidate
+		}
+	}
+	if loudest != nil {
+		t.current = loudest.speaker
+	}
+}
+
+// Current returns the most recent active speaker, or the zero Speaker if
+// nobody has spoken yet.
+func (t *SpeakerTracker) Current() Speaker {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.current
+}
+
+// Between returns the participant with the most speech energy between from
+// and to, falling back to the current speaker when nobody spoke in that
+// window. Transcription arrives after the audio it covers, so attributing by
+// the utterance's window avoids crediting whoever is talking on arrival.
+func (t *SpeakerTracker) Between(from, to time.Time) Speaker {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	totals := make(map[string]float64)
+	var best Speaker
+	var bestEnergy float64
+	for _, f := range t.history {
+		if f.at.Before(from) || f.at.After(to) {
+			continue
+		}
+		totals[f.speaker.Identity] += f.energy
+		if total := totals[f.speaker.Identity]; total > bestEnergy {
+			best = f.speaker
+			bestEnergy = total
+		}
+	}
+	if best.Identity == "" {
+		return t.current
+	}
+	return best
+}
+
+// Remove forgets a participant once their track goes away, so later input is
+// no longer attributed to them through the current speaker.
+func (t *SpeakerTracker) Remove(identity string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.levels, identity)
+	if t.current.Identity == identity {
+		t.current = Speaker{}
+	}
+}
+
+func rmsEnergy(sample media.PCM16Sample) float64 {
+	if len(sample) == 0 {
+		return 0
+	}
+	var sum float64
+	for _, v := range sample {
+		f := float64(v)
+		sum += f * f
+	}
+	return math.Sqrt(sum / float64(len(sample)))
+}
+
+// userTurnBuffer accumulates input transcription for the current turn and
+// starts a new segment whenever the attributed speaker changes.
+type userTurnBuffer struct {
+	segments []SessionTranscriptSegment
+	speaker  Speaker
+	content  string
+	start    time.Time
+	// lastChunk is when the previous transcription chunk arrived; it bounds
+	// the audio window the next chunk is attributed over.
+	lastChunk time.Time
+}
+
+// window returns the span of audio a transcription chunk arriving at now
+// covers: everything since the previous chunk, capped at maxUtteranceWindow.
+func (b *userTurnBuffer) window(now time.Time) (time.Time, time.Time) {
+	from := now.Add(-maxUtteranceWindow)
+	if b.lastChunk.After(from) {
+		from = b.lastChunk
+	}
+	return from, now
+}
+
+// add appends a transcription chunk that arrived at now.
+func (b *userTurnBuffer) add(text string, speaker Speaker, now time.Time) {
+	if text == "" {
+		return
+	}
+	b.lastChunk = now
+	if b.content != "" && speaker.Identity != b.speaker.Identity {
+		b.segments = append(b.segments, b.current())
+		b.content = ""
+	}
+	if b.content == "" {
+		b.speaker = speaker
+		b.start = now
+	}
+	b.content += " " + text
+}
+
+// current returns the in-progress segment for live streaming.
+func (b *userTurnBuffer) current() SessionTranscriptSegment {
+	return SessionTranscriptSegment{
+		Role:      "user",
+		Identity:  b.speaker.Identity,
+		Name:      b.speaker.Name,
+		Content:   strings.TrimSpace(b.content),
+		Timestamp: b.start,
+	}
+}
+
+// flush returns every segment of the turn and resets the buffer.
+func (b *userTurnBuffer) flush() []SessionTranscriptSegment {
+	segments := b.segments
+	if b.content != "" {
+		segments = append(segments, b.current())
+	}
+	b.segments = nil
+	b.content = ""
+	b.speaker = Speaker{}
+	return segments
+}
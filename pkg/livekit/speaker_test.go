@@ -0,0 +1,206 @@
+package livekit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/livekit/media-sdk"
+)
+
+var (
+	alice = Speaker{Identity: "alice", Name: "Alice"}
+	bob   = Speaker{Identity: "bob", Name: "Bob"}
+)
+
+// frame is a constant-amplitude sample whose RMS energy equals level.
+func frame(level int16) media.PCM16Sample {
+	sample := make(media.PCM16Sample, 160)
+	for i := range sample {
+		sample[i] = level
+	}
+	return sample
+}
+
+// burst is count consecutive 20ms frames of one participant.
+type burst struct {
+	speaker Speaker
+	level   int16
+	start   time.Duration
+	count   int
+}
+
+func observeBursts(tracker *SpeakerTracker, base time.Time, bursts []burst) {
+	for _, b := range bursts {
+		for i := 0; i < b.count; i++ {
+			tracker.observe(b.speaker, frame(b.level), base.Add(b.start+time.Duration(i)*20*time.Millisecond))
+		}
+	}
+}
+
+func TestSpeakerTrackerObserve(t *testing.T) {
+	base := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		bursts []burst
+		want   Speaker
+	}{
+		{"nobody spoke", nil, Speaker{}},
+		{"quiet audio is not speech", []burst{{alice, 300, 0, 20}}, Speaker{}},
+		{"speech makes a speaker current", []burst{{alice, 1000, 0, 5}}, alice},
+		{"louder speaker wins", []burst{{alice, 1000, 0, 5}, {bob, 2000, 0, 5}}, bob},
+		{"current speaker kept through a pause", []burst{{alice, 1000, 0, 5}, {alice, 0, 100 * time.Millisecond, 20}}, alice},
+		{"gone-quiet track is ignored", []burst{{alice, 3000, 0, 5}, {bob, 1000, time.Second, 5}}, bob},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := NewSpeakerTracker()
+			observeBursts(tracker, base, tt.bursts)
+			if got := tracker.Current(); got != tt.want {
+				t.Errorf("Current() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpeakerTrackerBetween(t *testing.T) {
+	base := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+	// Alice speaks for the first second, Bob for the next two; Bob stays
+	// the current speaker afterwards.
+	bursts := []burst{
+		{alice, 1000, 0, 50},
+		{bob, 1000, time.Second, 100},
+	}
+	tests := []struct {
+		name     string
+		from, to time.Duration
+		want     Speaker
+	}{
+		{"window over the first speaker", 0, 900 * time.Millisecond, alice},
+		{"window over the second speaker", 1500 * time.Millisecond, 2500 * time.Millisecond, bob},
+		{"most speech in the window wins", 500 * time.Millisecond, 3 * time.Second, bob},
+		{"silent window falls back to current", 10 * time.Second, 12 * time.Second, bob},
+		{"speech older than the history is forgotten", -time.Hour, -time.Minute, bob},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := NewSpeakerTracker()
+			observeBursts(tracker, base, bursts)
+			if got := tracker.Between(base.Add(tt.from), base.Add(tt.to)); got != tt.want {
+				t.Errorf("Between() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSpeakerTrackerHistoryPruned(t *testing.T) {
+	base := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+	tracker := NewSpeakerTracker()
+	observeBursts(tracker, base, []burst{
+		{alice, 1000, 0, 50},
+		{bob, 1000, speechHistoryTTL + time.Second, 5},
+	})
+	if got := tracker.Between(base, base.Add(time.Second)); got != bob {
+		t.Errorf("Between() over pruned history = %+v, want current speaker %+v", got, bob)
+	}
+}
+
+func TestSpeakerTrackerRemove(t *testing.T) {
+	base := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		remove string
+		want   Speaker
+	}{
+		{"removing the current speaker clears it", "alice", Speaker{}},
+		{"removing someone else keeps it", "bob", alice},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := NewSpeakerTracker()
+			observeBursts(tracker, base, []burst{{bob, 1000, 0, 5}, {alice, 2000, 0, 5}})
+			tracker.Remove(tt.remove)
+			if got := tracker.Current(); got != tt.want {
+				t.Errorf("Current() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserTurnBuffer(t *testing.T) {
+	base := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+	type chunk struct {
+		text    string
+		speaker Speaker
+		at      time.Duration
+	}
+	tests := []struct {
+		name   string
+		chunks []chunk
+		want   []SessionTranscriptSegment
+	}{
+		{"empty turn", nil, nil},
+		{"empty chunks are ignored", []chunk{{"", alice, 0}}, nil},
+		{
+			"one speaker is one segment",
+			[]chunk{{"hello", alice, 0}, {"there", alice, time.Second}},
+			[]SessionTranscriptSegment{
+				{Role: "user", Identity: "alice", Name: "Alice", Content: "hello there", Timestamp: base},
+			},
+		},
+		{
+			"speaker change starts a segment",
+			[]chunk{{"hello", alice, 0}, {"hi", bob, time.Second}, {"again", alice, 2 * time.Second}},
+			[]SessionTranscriptSegment{
+				{Role: "user", Identity: "alice", Name: "Alice", Content: "hello", Timestamp: base},
+				{Role: "user", Identity: "bob", Name: "Bob", Content: "hi", Timestamp: base.Add(time.Second)},
+				{Role: "user", Identity: "alice", Name: "Alice", Content: "again", Timestamp: base.Add(2 * time.Second)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buffer userTurnBuffer
+			for _, c := range tt.chunks {
+				buffer.add(c.text, c.speaker, base.Add(c.at))
+			}
+			got := buffer.flush()
+			if len(got) != len(tt.want) {
+				t.Fatalf("flush() returned %d segments, want %d: %+v", len(got), len(tt.want), got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("segment %d = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+			if rest := buffer.flush(); len(rest) != 0 {
+				t.Errorf("second flush() = %+v, want nothing", rest)
+			}
+		})
+	}
+}
+
+func TestUserTurnBufferWindow(t *testing.T) {
+	base := time.Date(2025, 12, 1, 10, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		lastChunk time.Duration // zero for no previous chunk
+		now       time.Duration
+		wantFrom  time.Duration
+	}{
+		{"first chunk covers the maximum window", 0, time.Minute, time.Minute - maxUtteranceWindow},
+		{"next chunk covers the audio since the last one", time.Minute, time.Minute + 2*time.Second, time.Minute},
+		{"long silence is capped", time.Minute, 2 * time.Minute, 2*time.Minute - maxUtteranceWindow},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buffer userTurnBuffer
+			if tt.lastChunk != 0 {
+				buffer.add("earlier", alice, base.Add(tt.lastChunk))
+			}
+			from, to := buffer.window(base.Add(tt.now))
+			if !from.Equal(base.Add(tt.wantFrom)) || !to.Equal(base.Add(tt.now)) {
+				t.Errorf("window() = [%v, %v], want [%v, %v]", from, to, base.Add(tt.wantFrom), base.Add(tt.now))
+			}
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package livekit
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+	"github.com/rahulSailesh-shah/converSense/pkg/agentconfig"
+	"github.com/rahulSailesh-shah/converSense/pkg/knowledge"
+	"google.golang.org/genai"
+)
+
+// toolCallTimeout bounds a single tool execution so a slow tool cannot stall
+// the model's turn indefinitely.
+const toolCallTimeout = 10 * time.Second
+
+// ToolInvocation is the meeting context a tool runs in.
+type ToolInvocation struct {
+	MeetingID uuid.UUID
+	AgentID   uuid.UUID
+	OrgID     uuid.UUID
+	UserID    string  // meeting owner
+	Speaker   Speaker // participant speaking when the model called the tool
+}
+
+// ToolHandler executes a tool call. The returned map is sent back to the
+// model as the function response.
+type ToolHandler func(ctx context.Context, inv ToolInvocation, args map[string]any) (map[string]any, error)
+
+// Tool is a Go function the realtime model can call during a meeting.
+type Tool struct {
+	Declaration *genai.FunctionDeclaration
+	Handler     ToolHandler
+}
+
+// ToolResultEvent is streamed to the room whenever the agent uses a tool.
+type ToolResultEvent struct {
+	Name   string         `json:"name"`
+	Args   map[string]any `json:"args,omitempty"`
+	Result map[string]any `json:"result,omitempty"`
+	Error  string         `json:"error,omitempty"`
+}
+
+// ToolRegistry holds the tools available to a session, in registration order.
+type ToolRegistry struct {
+	mu           sync.RWMutex
+	tools        map[string]Tool
+	order        []string
+	googleSearch bool
+}
+
+func NewToolRegistry(tools ...Tool) *ToolRegistry {
+	r := &ToolRegistry{
+		tools: make(map[string]Tool),
+	}
+	for _, tool := range tools {
+		r.Register(tool)
+	}
+	return r
+}
+
+// NewAgentToolRegistry builds the registry for the tools an agent has
+// enabled in its configuration.
+func NewAgentToolRegistry(queries *repo.Queries, kb *knowledge.Base, reminders ReminderScheduler, configs []agentconfig.Tool) *ToolRegistry {
+	r := NewToolRegistry()
+	builtins := make(map[string]Tool)
+	for _, tool := range BuiltinTools(queries, kb, reminders) {
+		builtins[tool.Declaration.Name] = tool
+	}
+
+	for _, config := range configs {
+		switch config.Type {
+		case agentconfig.ToolGoogleSearch:
+			r.EnableGoogleSearch()
+		case agentconfig.ToolHTTPWebhook:
+			r.Register(webhookTool(config.Webhook))
+		default:
+			if tool, ok := builtins[config.Type]; ok {
+				r.Register(tool)
+			}
+		}
+	}
+	return r
+}
+
+// EnableGoogleSearch grounds the model's answers with Google Search.
+func (r *ToolRegistry) EnableGoogleSearch() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.googleSearch = true
+}
+
+// Register adds a tool. Registering an existing name replaces the tool.
+func (r *ToolRegistry) Register(tool Tool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	name := tool.Declaration.Name
+	if _, exists := r.tools[name]; !exists {
+		r.order = append(r.order, name)
+	}
+	r.tools[name] = tool
+}
+
+// Declarations returns the function declarations to advertise to the model.
+func (r *ToolRegistry) Declarations() []*genai.FunctionDeclaration {
+	if r == nil {
+		return nil
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	declarations := make([]*genai.FunctionDeclaration, 0, len(r.order))
+	for _, name := range r.order {
+		declarations = append(declarations, r.tools[name].Declaration)
+	}
+	return declarations
+}
+
+// Execute runs a function call and always produces a response for it, so
+// the model is never left waiting on a failed or unknown tool.
+func (r *ToolRegistry) Execute(ctx context.Context, inv ToolInvocation, call *genai.FunctionCall) (*genai.FunctionResponse, error) {
+	response := &genai.FunctionResponse{
+		ID:   call.ID,
+		Name: call.Name,
+	}
+
+	var tool Tool
+	var ok bool
+	if r != nil {
+		r.mu.RLock()
+		tool, ok = r.tools[call.Name]
+		r.mu.RUnlock()
+	}
+	if !ok {
+		err := fmt.Errorf("unknown tool: %s", call.Name)
+		response.Response = map[string]any{"error": err.Error()}
+		return response, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, toolCallTimeout)
+	defer cancel()
+
+	result, err := tool.Handler(ctx, inv, call.Args)
+	if err != nil {
+		response.Response = map[string]any{"error": err.Error()}
+		return response, err
+	}
+	response.Response = map[string]any{"output": result}
+	return response, nil
+}
+
+// liveTools builds the Tools list for a Live session: Google Search when
+// enabled plus every registered function.
+func liveTools(tools *ToolRegistry) []*genai.Tool {
+	var liveTools []*genai.Tool
+	if tools != nil {
+		tools.mu.RLock()
+		googleSearch := tools.googleSearch
+		tools.mu.RUnlock()
+		if googleSearch {
+			liveTools = append(liveTools, &genai.Tool{
+				GoogleSearchRetrieval: &genai.GoogleSearchRetrieval{},
+			})
+		}
+	}
+	if declarations := tools.Declarations(); len(declarations) > 0 {
+		liveTools = append(liveTools, &genai.Tool{
+			FunctionDeclarations: declarations,
+		})
+	}
+	return liveTools
+}
+
+// runToolCalls executes every function call in a ToolCall message and sends
+// the responses back over the connection.
+func runToolCalls(ctx context.Context, conn *liveConnection, tools *ToolRegistry, inv ToolInvocation,
+	toolCall *genai.LiveServerToolCall, cb *GeminiRealtimeAPIHandlerCallbacks) {
+	responses := make([]*genai.FunctionResponse, 0, len(toolCall.FunctionCalls))
+	for _, call := range toolCall.FunctionCalls {
+		if call == nil {
+			continue
+		}
+		fmt.Println("[-] Running tool", "name", call.Name, "meetingID", inv.MeetingID)
+
+		response, err := tools.Execute(ctx, inv, call)
+		event := &ToolResultEvent{
+			Name: call.Name,
+			Args: call.Args,
+		}
+		if err != nil {
+			fmt.Printf("[ERROR] Tool %s failed: %v\n", call.Name, err)
+			event.Error = err.Error()
+		} else if output, ok := response.Response["output"].(map[string]any); ok {
+			event.Result = output
+		}
+		if cb != nil && cb.OnToolResult != nil {
+			cb.OnToolResult(event)
+		}
+		responses = append(responses, response)
+	}
+
+	if err := conn.sendToolResponse(genai.LiveToolResponseInput{
+		FunctionResponses: responses,
+	}); err != nil {
+		fmt.Printf("[ERROR] Failed to send tool response: %v\n", err)
+	}
+}
+
+func stringArg(args map[string]any, key string) string {
+	value, _ := args[key].(string)
+	return value
+}
+
+func numberArg(args map[string]any, key string) (float64, bool) {
+	value, ok := args[key].(float64)
+	return value, ok
+}
@@ -0,0 +1,279 @@
+package livekit
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"github.com/rahulSailesh-shah/converSense/internal/db/repo"
+	"github.com/rahulSailesh-shah/converSense/pkg/agentconfig"
+	"github.com/rahulSailesh-shah/converSense/pkg/knowledge"
+	"google.golang.org/genai"
+)
+
+// Names of the built-in tools.
+const (
+	ToolCreateActionItem     = agentconfig.ToolCreateActionItem
+	ToolLookupMeetingSummary = agentconfig.ToolLookupMeetingSummary
+	ToolSearchKnowledgeBase  = agentconfig.ToolSearchKnowledgeBase
+	ToolSetReminder          = agentconfig.ToolSetReminder
+	ToolTakeNote             = agentconfig.ToolTakeNote
+)
+
+const (
+	maxSummaryLookupResults    = 3
+	maxSummaryLookupCharacters = 2000
+	maxKnowledgeBaseResults    = 4
+)
+
+// ReminderScheduler delivers a saved reminder once it is due.
+type ReminderScheduler interface {
+	ScheduleReminder(ctx context.Context, noteID uuid.UUID, remindAt time.Time) error
+}
+
+// BuiltinTools returns the tools every meeting agent gets out of the box.
+// The knowledge base search is left out when kb is nil, and reminders when
+// nothing can deliver them.
+func BuiltinTools(queries *repo.Queries, kb *knowledge.Base, reminders ReminderScheduler) []Tool {
+	tools := []Tool{
+		createActionItemTool(queries),
+		takeNoteTool(queries),
+		lookupMeetingSummaryTool(queries),
+	}
+	if reminders != nil {
+		tools = append(tools, setReminderTool(queries, reminders))
+	}
+	if kb != nil {
+		tools = append(tools, searchKnowledgeBaseTool(kb))
+	}
+	return tools
+}
+
+func createActionItemTool(queries *repo.Queries) Tool {
+	return Tool{
+		Declaration: &genai.FunctionDeclaration{
+			Name:        ToolCreateActionItem,
+			Description: "Record a follow-up task agreed in the meeting. Use it whenever someone commits to doing something.",
+			Parameters: &genai.Schema{
+				Type: genai.TypeObject,
+				Properties: map[string]*genai.Schema{
+					"description": {Type: genai.TypeString, Description: "What needs to be done."},
+					"owner":       {Type: genai.TypeString, Description: "Name of the person responsible, if known."},
+					"due_date":    {Type: genai.TypeString, Description: "Due date as YYYY-MM-DD, if one was mentioned."},
+				},
+				Required: []string{"description"},
+			},
+		},
+		Handler: func(ctx context.Context, inv ToolInvocation, args map[string]any) (map[string]any, error) {
+			description := stringArg(args, "description")
+			if description == "" {
+				return nil, fmt.Errorf("description is required")
+			}
+
+			now := time.Now()
+			params := repo.CreateMeetingActionItemParams{
+				MeetingID:       inv.MeetingID,
+				Description:     description,
+				SourceTimestamp: &now,
+				Source:          "agent",
+			}
+			if owner := stringArg(args, "owner"); owner != "" {
+				params.Owner = &owner
+			}
+			if dueDate := stringArg(args, "due_date"); dueDate != "" {
+				due, err := time.Parse(time.DateOnly, dueDate)
+				if err != nil {
+					return nil, fmt.Errorf("due_date must be YYYY-MM-DD")
+				}
+				params.DueDate = &due
+			}
+			params.CreatedBy = speakerName(inv)
+
+			item, err := queries.CreateMeetingActionItem(ctx, params)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create action item: %w", err)
+			}
+			return map[string]any{
+				"id":          item.ID.String(),
+				"description": item.Description,
+				"status":      item.Status,
+			}, nil
+		},
+	}
+}
+
+func takeNoteTool(queries *repo.Queries) Tool {
+	return Tool{
+		Declaration: &genai.FunctionDeclaration{
+			Name:        ToolTakeNote,
+			Description: "Save a note for the meeting record when a participant asks you to note something down.",
+			Parameters: &genai.Schema{
+				Type: genai.TypeObject,
+				Properties: map[string]*genai.Schema{
+					"content": {Type: genai.TypeString, Description: "The note to save."},
+				},
+				Required: []string{"content"},
+			},
+		},
+		Handler: func(ctx context.Context, inv ToolInvocation, args map[string]any) (map[string]any, error) {
+			content := stringArg(args, "content")
+			if content == "" {
+				return nil, fmt.Errorf("content is required")
+			}
+
+			note, err := queries.CreateMeetingNote(ctx, repo.CreateMeetingNoteParams{
+				MeetingID: inv.MeetingID,
+				Kind:      "note",
+				Content:   content,
+				Author:    speakerName(inv),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to save note: %w", err)
+			}
+			return map[string]any{"id": note.ID.String()}, nil
+		},
+	}
+}
+
+func setReminderTool(queries *repo.Queries, reminders ReminderScheduler) Tool {
+	return Tool{
+		Declaration: &genai.FunctionDeclaration{
+			Name:        ToolSetReminder,
+			Description: "Set a reminder for the meeting participants, e.g. to revisit a topic later. When it is due it is sent to the workspace's reminder webhooks.",
+			Parameters: &genai.Schema{
+				Type: genai.TypeObject,
+				Properties: map[string]*genai.Schema{
+					"content":           {Type: genai.TypeString, Description: "What to be reminded about."},
+					"remind_in_minutes": {Type: genai.TypeNumber, Description: "Minutes from now when the reminder is due."},
+				},
+				Required: []string{"content", "remind_in_minutes"},
+			},
+		},
+		Handler: func(ctx context.Context, inv ToolInvocation, args map[string]any) (map[string]any, error) {
+			content := stringArg(args, "content")
+			if content == "" {
+				return nil, fmt.Errorf("content is required")
+			}
+			minutes, ok := numberArg(args, "remind_in_minutes")
+			if !ok || minutes <= 0 {
+				return nil, fmt.Errorf("remind_in_minutes must be a positive number")
+			}
+
+			remindAt := time.Now().Add(time.Duration(minutes * float64(time.Minute)))
+			note, err := queries.CreateMeetingNote(ctx, repo.CreateMeetingNoteParams{
+				MeetingID: inv.MeetingID,
+				Kind:      "reminder",
+				Content:   content,
+				Author:    speakerName(inv),
+				RemindAt:  &remindAt,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to set reminder: %w", err)
+			}
+			if err := reminders.ScheduleReminder(ctx, note.ID, remindAt); err != nil {
+				return nil, fmt.Errorf("failed to schedule reminder: %w", err)
+			}
+			return map[string]any{
+				"id":       note.ID.String(),
+				"remindAt": remindAt.Format(time.RFC3339),
+			}, nil
+		},
+	}
+}
+
+func lookupMeetingSummaryTool(queries *repo.Queries) Tool {
+	return Tool{
+		Declaration: &genai.FunctionDeclaration{
+			Name:        ToolLookupMeetingSummary,
+			Description: "Look up the summaries of past meetings in the organization by meeting name.",
+			Parameters: &genai.Schema{
+				Type: genai.TypeObject,
+				Properties: map[string]*genai.Schema{
+					"query": {Type: genai.TypeString, Description: "Part of the meeting name to search for. Empty returns the most recent meetings."},
+				},
+			},
+		},
+		Handler: func(ctx context.Context, inv ToolInvocation, args map[string]any) (map[string]any, error) {
+			rows, err := queries.SearchMeetingSummaries(ctx, repo.SearchMeetingSummariesParams{
+				OrgID:       inv.OrgID,
+				Query:       stringArg(args, "query"),
+				ResultLimit: maxSummaryLookupResults,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("failed to search meetings: %w", err)
+			}
+
+			meetings := make([]map[string]any, 0, len(rows))
+			for _, row := range rows {
+				if row.ID == inv.MeetingID || row.Summary == nil {
+					continue
+				}
+				meeting := map[string]any{
+					"name":    row.Name,
+					"summary": truncate(*row.Summary, maxSummaryLookupCharacters),
+				}
+				if row.StartTime != nil {
+					meeting["date"] = row.StartTime.Format(time.DateOnly)
+				}
+				meetings = append(meetings, meeting)
+			}
+			return map[string]any{"meetings": meetings}, nil
+		},
+	}
+}
+
+func searchKnowledgeBaseTool(kb *knowledge.Base) Tool {
+	return Tool{
+		Declaration: &genai.FunctionDeclaration{
+			Name:        ToolSearchKnowledgeBase,
+			Description: "Search the documents uploaded to your knowledge base. Use it before answering questions about the product, policies or anything you are unsure of.",
+			Parameters: &genai.Schema{
+				Type: genai.TypeObject,
+				Properties: map[string]*genai.Schema{
+					"query": {Type: genai.TypeString, Description: "What to look up, phrased as a question or keywords."},
+				},
+				Required: []string{"query"},
+			},
+		},
+		Handler: func(ctx context.Context, inv ToolInvocation, args map[string]any) (map[string]any, error) {
+			query := stringArg(args, "query")
+			if query == "" {
+				return nil, fmt.Errorf("query is required")
+			}
+
+			chunks, err := kb.Search(ctx, inv.AgentID, query, maxKnowledgeBaseResults)
+			if err != nil {
+				return nil, err
+			}
+			results := make([]map[string]any, 0, len(chunks))
+			for _, chunk := range chunks {
+				results = append(results, map[string]any{
+					"document": chunk.DocumentName,
+					"content":  chunk.Content,
+				})
+			}
+			return map[string]any{"results": results}, nil
+		},
+	}
+}
+
+func speakerName(inv ToolInvocation) *string {
+	if inv.Speaker.Name == "" {
+		return nil
+	}
+	return &inv.Speaker.Name
+}
+
+// truncate shortens s to at most max bytes, cutting on a rune boundary.
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	cut := max
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut] + "..."
+}
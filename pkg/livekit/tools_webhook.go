@@ -0,0 +1,111 @@
+package livekit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/rahulSailesh-shah/converSense/pkg/agentconfig"
+	"github.com/rahulSailesh-shah/converSense/pkg/safehttp"
+	"google.golang.org/genai"
+)
+
+// maxWebhookResponseBytes caps how much of a webhook's response is handed
+// back to the model.
+const maxWebhookResponseBytes = 64 * 1024
+
+var webhookSchemaTypes = map[string]genai.Type{
+	"string":  genai.TypeString,
+	"number":  genai.TypeNumber,
+	"integer": genai.TypeInteger,
+	"boolean": genai.TypeBoolean,
+}
+
+type webhookRequest struct {
+	Tool      string         `json:"tool"`
+	MeetingID string         `json:"meetingId"`
+	Speaker   string         `json:"speaker,omitempty"`
+	Arguments map[string]any `json:"arguments"`
+}
+
+// webhookTool exposes an agent-configured HTTP endpoint as a function.
+func webhookTool(webhook *agentconfig.WebhookConfig) Tool {
+	properties := make(map[string]*genai.Schema, len(webhook.Parameters))
+	for name, parameter := range webhook.Parameters {
+		properties[name] = &genai.Schema{
+			Type:        webhookSchemaTypes[parameter.Type],
+			Description: parameter.Description,
+		}
+	}
+
+	method := strings.ToUpper(webhook.Method)
+	if method == "" {
+		method = http.MethodPost
+	}
+	timeout := toolCallTimeout
+	if webhook.TimeoutSec > 0 {
+		timeout = time.Duration(webhook.TimeoutSec) * time.Second
+	}
+	// Agent editors choose the URL, so it must not reach internal services,
+	// and redirects are not followed since they would carry the configured
+	// headers to another host.
+	client := safehttp.NewClient(timeout, false)
+
+	return Tool{
+		Declaration: &genai.FunctionDeclaration{
+			Name:        webhook.Name,
+			Description: webhook.Description,
+			Parameters: &genai.Schema{
+				Type:       genai.TypeObject,
+				Properties: properties,
+				Required:   webhook.Required,
+			},
+		},
+		Handler: func(ctx context.Context, inv ToolInvocation, args map[string]any) (map[string]any, error) {
+			body, err := json.Marshal(webhookRequest{
+				Tool:      webhook.Name,
+				MeetingID: inv.MeetingID.String(),
+				Speaker:   inv.Speaker.Name,
+				Arguments: args,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			req, err := http.NewRequestWithContext(ctx, method, webhook.URL, bytes.NewReader(body))
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Content-Type", "application/json")
+			for key, value := range webhook.Headers {
+				req.Header.Set(key, value)
+			}
+
+			res, err := client.Do(req)
+			if err != nil {
+				return nil, fmt.Errorf("webhook request failed: %w", err)
+			}
+			defer res.Body.Close()
+
+			responseBody, err := io.ReadAll(io.LimitReader(res.Body, maxWebhookResponseBytes))
+			if err != nil {
+				return nil, fmt.Errorf("failed to read webhook response: %w", err)
+			}
+			if res.StatusCode >= 300 {
+				return nil, fmt.Errorf("webhook returned status %d", res.StatusCode)
+			}
+
+			var result map[string]any
+			if err := json.Unmarshal(responseBody, &result); err != nil {
+				// Not a JSON object; hand the raw body to the model.
+				result = map[string]any{"response": string(responseBody)}
+			}
+			return result, nil
+		},
+	}
+}
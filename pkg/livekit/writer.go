@@ -6,18 +6,52 @@ import (
 	"go.uber.org/atomic"
 
 	"github.com/livekit/media-sdk"
+	"github.com/livekit/media-sdk/mixer"
 )
 
 var ErrClosed = errors.New("writer is closed")
 
+// modelInputSampleRate is the PCM rate the realtime models expect as input.
+const modelInputSampleRate = 16000
+
+// ModelWriter receives the mixed room audio and forwards it to the realtime model.
+type ModelWriter struct {
+	handler RealtimeModel
+}
+
+func NewModelWriter(handler RealtimeModel) *ModelWriter {
+	return &ModelWriter{
+		handler: handler,
+	}
+}
+
+func (w *ModelWriter) String() string {
+	return "RealtimeModel"
+}
+
+func (w *ModelWriter) SampleRate() int {
+	return modelInputSampleRate
+}
+
+func (w *ModelWriter) WriteSample(sample media.PCM16Sample) error {
+	return w.handler.SendAudioChunk(sample)
+}
+
+// RemoteTrackWriter routes a single participant's decoded audio into the
+// session mixer so every speaker in the room reaches the model, and reports
+// its energy to the speaker tracker for transcript attribution.
 type RemoteTrackWriter struct {
-	handler *GeminiRealtimeAPIHandler
-	closed  atomic.Bool
+	speaker  Speaker
+	input    *mixer.Input
+	speakers *SpeakerTracker
+	closed   atomic.Bool
 }
 
-func NewRemoteTrackWriter(handler *GeminiRealtimeAPIHandler) *RemoteTrackWriter {
+func NewRemoteTrackWriter(speaker Speaker, input *mixer.Input, speakers *SpeakerTracker) *RemoteTrackWriter {
 	return &RemoteTrackWriter{
-		handler: handler,
+		speaker:  speaker,
+		input:    input,
+		speakers: speakers,
 	}
 }
 
@@ -26,10 +60,13 @@ func (w *RemoteTrackWriter) WriteSample(sample media.PCM16Sample) error {
 		return ErrClosed
 	}
 
-	return w.handler.SendAudioChunk(sample)
+	w.speakers.Observe(w.speaker, sample)
+	return w.input.WriteSample(sample)
 }
 
 func (w *RemoteTrackWriter) Close() error {
-	w.closed.Swap(true)
-	return nil
+	if w.closed.Swap(true) {
+		return nil
+	}
+	return w.input.Close()
 }
@@ -0,0 +1,66 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rahulSailesh-shah/converSense/pkg/config"
+)
+
+// Embedding tasks. Providers that tune vectors for retrieval use them; the
+// others ignore them.
+const (
+	EmbedDocument = "document"
+	EmbedQuery    = "query"
+)
+
+type EmbedRequest struct {
+	Model string
+	Texts []string
+	Task  string
+	// Title names the document the texts were taken from, if any
+	Title string
+	// Dimensions truncates the vectors; zero keeps the model's own size
+	Dimensions int
+}
+
+// Embedder turns texts into vectors, one per text in the same order.
+type Embedder interface {
+	Embed(ctx context.Context, request EmbedRequest) ([][]float32, error)
+}
+
+// EmbeddingModel is an embedder bound to the configured embedding model.
+type EmbeddingModel struct {
+	embedder Embedder
+	name     string
+}
+
+// NewEmbeddingModel creates the provider embeddings are configured to use.
+func NewEmbeddingModel(ctx context.Context, cfg *config.AppConfig, task config.ModelConfig) (*EmbeddingModel, error) {
+	provider, err := newProvider(ctx, cfg, task.Provider)
+	if err != nil {
+		return nil, err
+	}
+	embedder, ok := provider.(Embedder)
+	if !ok {
+		return nil, fmt.Errorf("LLM provider %q cannot embed text", task.Provider)
+	}
+	return &EmbeddingModel{embedder: embedder, name: task.Model}, nil
+}
+
+// Name returns the model name requests are sent with.
+func (m *EmbeddingModel) Name() string {
+	return m.name
+}
+
+func (m *EmbeddingModel) Embed(ctx context.Context, request EmbedRequest) ([][]float32, error) {
+	request.Model = m.name
+	embeddings, err := m.embedder.Embed(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	if len(embeddings) != len(request.Texts) {
+		return nil, fmt.Errorf("expected %d embeddings, got %d", len(request.Texts), len(embeddings))
+	}
+	return embeddings, nil
+}
@@ -0,0 +1,116 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/genai"
+)
+
+type Gemini struct {
+	client *genai.Client
+}
+
+func NewGemini(ctx context.Context, apiKey string) (*Gemini, error) {
+	client, err := genai.NewClient(ctx, &genai.ClientConfig{
+		APIKey:  apiKey,
+		Backend: genai.BackendGeminiAPI,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Gemini client: %w", err)
+	}
+	return &Gemini{client: client}, nil
+}
+
+func (p *Gemini) Complete(ctx context.Context, request Request) (string, error) {
+	contents, generateConfig := p.request(request)
+	response, err := p.client.Models.GenerateContent(ctx, request.Model, contents, generateConfig)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate content: %w", err)
+	}
+	return response.Text(), nil
+}
+
+func (p *Gemini) Stream(ctx context.Context, request Request, onChunk func(chunk string) error) (string, error) {
+	contents, generateConfig := p.request(request)
+
+	var full strings.Builder
+	for response, err := range p.client.Models.GenerateContentStream(ctx, request.Model, contents, generateConfig) {
+		if err != nil {
+			return full.String(), fmt.Errorf("failed to stream content: %w", err)
+		}
+		text := response.Text()
+		if text == "" {
+			continue
+		}
+		full.WriteString(text)
+		if err := onChunk(text); err != nil {
+			return full.String(), err
+		}
+	}
+	return full.String(), nil
+}
+
+// request maps the conversation onto Gemini, which takes system messages
+// as a separate instruction and calls the assistant "model".
+func (p *Gemini) request(request Request) ([]*genai.Content, *genai.GenerateContentConfig) {
+	generateConfig := &genai.GenerateContentConfig{
+		Temperature: request.Temperature,
+	}
+	if request.JSON {
+		generateConfig.ResponseMIMEType = "application/json"
+	}
+
+	var system []string
+	var contents []*genai.Content
+	for _, message := range request.Messages {
+		switch message.Role {
+		case RoleSystem:
+			system = append(system, message.Content)
+		case RoleAssistant:
+			contents = append(contents, genai.NewContentFromText(message.Content, genai.RoleModel))
+		default:
+			parts := make([]*genai.Part, 0, len(message.Attachments)+1)
+			for _, attachment := range message.Attachments {
+				parts = append(parts, genai.NewPartFromBytes(attachment.Data, attachment.MIMEType))
+			}
+			parts = append(parts, genai.NewPartFromText(message.Content))
+			contents = append(contents, genai.NewContentFromParts(parts, genai.RoleUser))
+		}
+	}
+	if len(system) > 0 {
+		generateConfig.SystemInstruction = genai.NewContentFromText(strings.Join(system, "\n\n"), genai.RoleUser)
+	}
+	return contents, generateConfig
+}
+
+func (p *Gemini) Embed(ctx context.Context, request EmbedRequest) ([][]float32, error) {
+	embedConfig := &genai.EmbedContentConfig{}
+	switch request.Task {
+	case EmbedDocument:
+		embedConfig.TaskType = "RETRIEVAL_DOCUMENT"
+		embedConfig.Title = request.Title
+	case EmbedQuery:
+		embedConfig.TaskType = "RETRIEVAL_QUERY"
+	}
+	if request.Dimensions > 0 {
+		dimensions := int32(request.Dimensions)
+		embedConfig.OutputDimensionality = &dimensions
+	}
+
+	contents := make([]*genai.Content, 0, len(request.Texts))
+	for _, text := range request.Texts {
+		contents = append(contents, genai.NewContentFromText(text, genai.RoleUser))
+	}
+	response, err := p.client.Models.EmbedContent(ctx, request.Model, contents, embedConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed text: %w", err)
+	}
+
+	embeddings := make([][]float32, 0, len(response.Embeddings))
+	for _, embedding := range response.Embeddings {
+		embeddings = append(embeddings, embedding.Values)
+	}
+	return embeddings, nil
+}
@@ -0,0 +1,202 @@
+// Package llm puts the text models ConverSense calls outside of live
+// meetings behind one interface, so each task can be pointed at any
+// provider from configuration.
+package llm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/rahulSailesh-shah/converSense/pkg/config"
+)
+
+// Supported providers.
+const (
+	ProviderOpenAI = "openai" // any OpenAI-compatible API
+	ProviderGemini = "gemini"
+	ProviderOllama = "ollama"
+)
+
+// Message roles.
+const (
+	RoleSystem    = "system"
+	RoleUser      = "user"
+	RoleAssistant = "assistant"
+)
+
+type Message struct {
+	Role    string
+	Content string
+	// Attachments are files sent along with a user message, e.g. a PDF
+	Attachments []Attachment
+}
+
+type Attachment struct {
+	MIMEType string
+	Data     []byte
+}
+
+func System(content string) Message    { return Message{Role: RoleSystem, Content: content} }
+func User(content string) Message      { return Message{Role: RoleUser, Content: content} }
+func Assistant(content string) Message { return Message{Role: RoleAssistant, Content: content} }
+
+// UserWithAttachments is a user message that carries files, which come
+// before the text.
+func UserWithAttachments(content string, attachments ...Attachment) Message {
+	return Message{Role: RoleUser, Content: content, Attachments: attachments}
+}
+
+type Request struct {
+	Model       string
+	Messages    []Message
+	Temperature *float32
+	// JSON asks the provider to answer with a single JSON object
+	JSON bool
+}
+
+// Provider generates text from a conversation.
+type Provider interface {
+	Complete(ctx context.Context, request Request) (string, error)
+	// Stream calls onChunk with each piece of the answer as it arrives and
+	// returns the full answer. An error from onChunk stops the stream.
+	Stream(ctx context.Context, request Request, onChunk func(chunk string) error) (string, error)
+}
+
+// Model is a provider bound to the model configured for one task.
+type Model struct {
+	provider    Provider
+	name        string
+	temperature *float32
+}
+
+// Models holds the model for each task.
+type Models struct {
+	Summary        *Model
+	Chat           *Model
+	Sentiment      *Model
+	RollingSummary *Model
+	Document       *Model
+	Embedding      *EmbeddingModel
+}
+
+// NewModels creates the models configured in cfg.LLM.
+func NewModels(ctx context.Context, cfg *config.AppConfig) (*Models, error) {
+	summary, err := NewModel(ctx, cfg, cfg.LLM.Summary)
+	if err != nil {
+		return nil, fmt.Errorf("summary model: %w", err)
+	}
+	chat, err := NewModel(ctx, cfg, cfg.LLM.Chat)
+	if err != nil {
+		return nil, fmt.Errorf("chat model: %w", err)
+	}
+	sentiment, err := NewModel(ctx, cfg, cfg.LLM.Sentiment)
+	if err != nil {
+		return nil, fmt.Errorf("sentiment model: %w", err)
+	}
+	rollingSummary, err := NewModel(ctx, cfg, cfg.LLM.RollingSummary)
+	if err != nil {
+		return nil, fmt.Errorf("rolling summary model: %w", err)
+	}
+	document, err := NewModel(ctx, cfg, cfg.LLM.Document)
+	if err != nil {
+		return nil, fmt.Errorf("document model: %w", err)
+	}
+	embedding, err := NewEmbeddingModel(ctx, cfg, cfg.LLM.Embedding)
+	if err != nil {
+		return nil, fmt.Errorf("embedding model: %w", err)
+	}
+	return &Models{
+		Summary:        summary,
+		Chat:           chat,
+		Sentiment:      sentiment,
+		RollingSummary: rollingSummary,
+		Document:       document,
+		Embedding:      embedding,
+	}, nil
+}
+
+// NewModel creates the provider a task is configured to use.
+func NewModel(ctx context.Context, cfg *config.AppConfig, task config.ModelConfig) (*Model, error) {
+	provider, err := newProvider(ctx, cfg, task.Provider)
+	if err != nil {
+		return nil, err
+	}
+	return &Model{provider: provider, name: task.Model}, nil
+}
+
+func newProvider(ctx context.Context, cfg *config.AppConfig, name string) (Provider, error) {
+	switch name {
+	case ProviderOpenAI:
+		return NewOpenAI(cfg.OpenAI.APIKey, cfg.OpenAI.BaseURL), nil
+	case ProviderGemini:
+		return NewGemini(ctx, cfg.Gemini.APIKey)
+	case ProviderOllama:
+		return NewOllama(cfg.LLM.OllamaHost)
+	default:
+		return nil, fmt.Errorf("unknown LLM provider %q", name)
+	}
+}
+
+// Name returns the model name requests are sent with.
+func (m *Model) Name() string {
+	return m.name
+}
+
+// WithName returns a copy of the model that sends requests to another model
+// of the same provider.
+func (m *Model) WithName(name string) *Model {
+	copied := *m
+	copied.name = name
+	return &copied
+}
+
+// WithTemperature returns a copy of the model that samples at temperature.
+func (m *Model) WithTemperature(temperature float32) *Model {
+	copied := *m
+	copied.temperature = &temperature
+	return &copied
+}
+
+func (m *Model) request(messages []Message) Request {
+	return Request{Model: m.name, Messages: messages, Temperature: m.temperature}
+}
+
+func (m *Model) Complete(ctx context.Context, messages ...Message) (string, error) {
+	return m.provider.Complete(ctx, m.request(messages))
+}
+
+func (m *Model) Stream(ctx context.Context, messages []Message, onChunk func(chunk string) error) (string, error) {
+	return m.provider.Stream(ctx, m.request(messages), onChunk)
+}
+
+// CompleteJSON asks for a JSON answer and decodes it strictly into out, so
+// answers that drift from the expected shape are rejected.
+func (m *Model) CompleteJSON(ctx context.Context, out any, messages ...Message) error {
+	request := m.request(messages)
+	request.JSON = true
+	content, err := m.provider.Complete(ctx, request)
+	if err != nil {
+		return err
+	}
+	return DecodeJSON(content, out)
+}
+
+// DecodeJSON decodes the JSON object in content into out, tolerating the
+// markdown fences and surrounding prose models tend to add.
+func DecodeJSON(content string, out any) error {
+	start := strings.Index(content, "{")
+	end := strings.LastIndex(content, "}")
+	if start == -1 || end < start {
+		return fmt.Errorf("no JSON object in model output")
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(content[start : end+1])))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(out); err != nil {
+		return fmt.Errorf("invalid JSON in model output: %w", err)
+	}
+	return nil
+}
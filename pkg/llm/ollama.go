@@ -0,0 +1,87 @@
+package llm
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ollama/ollama/api"
+)
+
+type Ollama struct {
+	client *api.Client
+}
+
+func NewOllama(host string) (*Ollama, error) {
+	base, err := url.Parse(host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Ollama host %q: %w", host, err)
+	}
+	return &Ollama{client: api.NewClient(base, http.DefaultClient)}, nil
+}
+
+func (p *Ollama) Complete(ctx context.Context, request Request) (string, error) {
+	return p.chat(ctx, request, false, nil)
+}
+
+func (p *Ollama) Stream(ctx context.Context, request Request, onChunk func(chunk string) error) (string, error) {
+	return p.chat(ctx, request, true, onChunk)
+}
+
+func (p *Ollama) chat(ctx context.Context, request Request, stream bool, onChunk func(chunk string) error) (string, error) {
+	messages := make([]api.Message, 0, len(request.Messages))
+	for _, message := range request.Messages {
+		chatMessage := api.Message{Role: message.Role, Content: message.Content}
+		for _, attachment := range message.Attachments {
+			if !strings.HasPrefix(attachment.MIMEType, "image/") {
+				return "", fmt.Errorf("ollama cannot read %s attachments", attachment.MIMEType)
+			}
+			chatMessage.Images = append(chatMessage.Images, api.ImageData(attachment.Data))
+		}
+		messages = append(messages, chatMessage)
+	}
+	chatRequest := &api.ChatRequest{
+		Model:    request.Model,
+		Messages: messages,
+		Stream:   &stream,
+		Options:  map[string]any{},
+	}
+	if request.Temperature != nil {
+		chatRequest.Options["temperature"] = *request.Temperature
+	}
+	if request.JSON {
+		chatRequest.Format = json.RawMessage(`"json"`)
+	}
+
+	var full strings.Builder
+	err := p.client.Chat(ctx, chatRequest, func(response api.ChatResponse) error {
+		text := response.Message.Content
+		if text == "" {
+			return nil
+		}
+		full.WriteString(text)
+		if onChunk != nil {
+			return onChunk(text)
+		}
+		return nil
+	})
+	if err != nil {
+		return full.String(), fmt.Errorf("ollama chat error: %w", err)
+	}
+	return full.String(), nil
+}
+
+func (p *Ollama) Embed(ctx context.Context, request EmbedRequest) ([][]float32, error) {
+	response, err := p.client.Embed(ctx, &api.EmbedRequest{
+		Model:      request.Model,
+		Input:      request.Texts,
+		Dimensions: request.Dimensions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("ollama embed error: %w", err)
+	}
+	return response.Embeddings, nil
+}
@@ -0,0 +1,135 @@
+package llm
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/openai/openai-go"
+	"github.com/openai/openai-go/option"
+	"github.com/openai/openai-go/shared"
+)
+
+// OpenAI talks to any OpenAI-compatible chat completions API.
+type OpenAI struct {
+	client openai.Client
+}
+
+func NewOpenAI(apiKey string, baseURL string) *OpenAI {
+	options := []option.RequestOption{option.WithAPIKey(apiKey)}
+	if baseURL != "" {
+		options = append(options, option.WithBaseURL(baseURL))
+	}
+	return &OpenAI{client: openai.NewClient(options...)}
+}
+
+func (p *OpenAI) Complete(ctx context.Context, request Request) (string, error) {
+	response, err := p.client.Chat.Completions.New(ctx, p.params(request))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate content: %w", err)
+	}
+	if len(response.Choices) == 0 {
+		return "", fmt.Errorf("model returned no choices")
+	}
+	return response.Choices[0].Message.Content, nil
+}
+
+func (p *OpenAI) Stream(ctx context.Context, request Request, onChunk func(chunk string) error) (string, error) {
+	stream := p.client.Chat.Completions.NewStreaming(ctx, p.params(request))
+	defer stream.Close()
+
+	var full strings.Builder
+	for stream.Next() {
+		chunk := stream.Current()
+		if len(chunk.Choices) == 0 || chunk.Choices[0].Delta.Content == "" {
+			continue
+		}
+		text := chunk.Choices[0].Delta.Content
+		full.WriteString(text)
+		if err := onChunk(text); err != nil {
+			return full.String(), err
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return full.String(), fmt.Errorf("failed to stream content: %w", err)
+	}
+	return full.String(), nil
+}
+
+func (p *OpenAI) params(request Request) openai.ChatCompletionNewParams {
+	messages := make([]openai.ChatCompletionMessageParamUnion, 0, len(request.Messages))
+	for _, message := range request.Messages {
+		switch message.Role {
+		case RoleSystem:
+			messages = append(messages, openai.SystemMessage(message.Content))
+		case RoleAssistant:
+			messages = append(messages, openai.AssistantMessage(message.Content))
+		default:
+			if len(message.Attachments) == 0 {
+				messages = append(messages, openai.UserMessage(message.Content))
+				continue
+			}
+			parts := make([]openai.ChatCompletionContentPartUnionParam, 0, len(message.Attachments)+1)
+			for _, attachment := range message.Attachments {
+				parts = append(parts, attachmentPart(attachment))
+			}
+			parts = append(parts, openai.TextContentPart(message.Content))
+			messages = append(messages, openai.UserMessage(parts))
+		}
+	}
+
+	params := openai.ChatCompletionNewParams{
+		Model:    request.Model,
+		Messages: messages,
+	}
+	if request.Temperature != nil {
+		params.Temperature = openai.Float(float64(*request.Temperature))
+	}
+	if request.JSON {
+		params.ResponseFormat = openai.ChatCompletionNewParamsResponseFormatUnion{
+			OfJSONObject: &shared.ResponseFormatJSONObjectParam{},
+		}
+	}
+	return params
+}
+
+// attachmentPart sends images as image input and any other file as file
+// input, both inlined as data URLs.
+func attachmentPart(attachment Attachment) openai.ChatCompletionContentPartUnionParam {
+	dataURL := "data:" + attachment.MIMEType + ";base64," + base64.StdEncoding.EncodeToString(attachment.Data)
+	if strings.HasPrefix(attachment.MIMEType, "image/") {
+		return openai.ImageContentPart(openai.ChatCompletionContentPartImageImageURLParam{URL: dataURL})
+	}
+	return openai.FileContentPart(openai.ChatCompletionContentPartFileFileParam{
+		FileData: openai.String(dataURL),
+		Filename: openai.String("attachment"),
+	})
+}
+
+func (p *OpenAI) Embed(ctx context.Context, request EmbedRequest) ([][]float32, error) {
+	params := openai.EmbeddingNewParams{
+		Model: request.Model,
+		Input: openai.EmbeddingNewParamsInputUnion{OfArrayOfStrings: request.Texts},
+	}
+	if request.Dimensions > 0 {
+		params.Dimensions = openai.Int(int64(request.Dimensions))
+	}
+	response, err := p.client.Embeddings.New(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed text: %w", err)
+	}
+
+	embeddings := make([][]float32, len(response.Data))
+	for _, embedding := range response.Data {
+		if embedding.Index < 0 || int(embedding.Index) >= len(embeddings) {
+			return nil, fmt.Errorf("embedding index %d out of range", embedding.Index)
+		}
+		vector := make([]float32, len(embedding.Embedding))
+		for i, value := range embedding.Embedding {
+			vector[i] = float32(value)
+		}
+		embeddings[embedding.Index] = vector
+	}
+	return embeddings, nil
+}
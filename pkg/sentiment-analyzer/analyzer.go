@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"time"
+
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
 )
 
 type SentimentResult struct {
@@ -20,28 +22,10 @@ type SentimentAnalyzer interface {
 	Close() error
 }
 
-type SentimentAnalyzerType string
-
-const (
-	AnalyzerTypeOllama SentimentAnalyzerType = "ollama"
-	AnalyzerTypeOpenAI SentimentAnalyzerType = "openai"
-	AnalyzerTypeClaude SentimentAnalyzerType = "claude"
-)
-
-func NewSentimentAnalyzer(analyzerType SentimentAnalyzerType) (SentimentAnalyzer, error) {
-	switch analyzerType {
-	case AnalyzerTypeOllama:
-		ollamaHost := "http://localhost:11434"
-		model := "llama3.2:3b"
-		return NewOllamaSentimentAnalyzer(ollamaHost, model)
-
-	case AnalyzerTypeOpenAI:
-		return nil, fmt.Errorf("openAI sentiment analyzer not yet implemented")
-
-	case AnalyzerTypeClaude:
-		return nil, fmt.Errorf("claude sentiment analyzer not yet implemented")
-
-	default:
-		return nil, fmt.Errorf("unknown analyzer type: %s", analyzerType)
+// NewSentimentAnalyzer creates an analyzer backed by the given model.
+func NewSentimentAnalyzer(model *llm.Model) (SentimentAnalyzer, error) {
+	if model == nil {
+		return nil, fmt.Errorf("no sentiment model configured")
 	}
+	return NewLLMSentimentAnalyzer(model), nil
 }
@@ -2,17 +2,16 @@ package sentimentanalyzer
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
 
-	"github.com/ollama/ollama/api"
+	"github.com/rahulSailesh-shah/converSense/pkg/llm"
 )
 
-type OllamaSentimentAnalyzer struct {
-	client       *api.Client
-	model        string
+// LLMSentimentAnalyzer classifies text with the configured sentiment model.
+type LLMSentimentAnalyzer struct {
+	model        *llm.Model
 	analysisChan chan analysisRequest
 	ctx          context.Context
 	cancel       context.CancelFunc
@@ -25,17 +24,11 @@ type analysisRequest struct {
 	errCh    chan error
 }
 
-func NewOllamaSentimentAnalyzer(ollamaHost string, model string) (*OllamaSentimentAnalyzer, error) {
-	client, err := api.ClientFromEnvironment()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create Ollama client: %w", err)
-	}
-
+func NewLLMSentimentAnalyzer(model *llm.Model) *LLMSentimentAnalyzer {
 	ctx, cancel := context.WithCancel(context.Background())
 
-	analyzer := &OllamaSentimentAnalyzer{
-		client:       client,
-		model:        model,
+	analyzer := &LLMSentimentAnalyzer{
+		model:        model.WithTemperature(0.1),
 		analysisChan: make(chan analysisRequest, 10),
 		ctx:          ctx,
 		cancel:       cancel,
@@ -43,11 +36,11 @@ func NewOllamaSentimentAnalyzer(ollamaHost string, model string) (*OllamaSentime
 
 	go analyzer.worker()
 
-	return analyzer, nil
+	return analyzer
 }
 
 // worker processes sentiment analysis requests asynchronously
-func (a *OllamaSentimentAnalyzer) worker() {
+func (a *LLMSentimentAnalyzer) worker() {
 	for {
 		select {
 		case <-a.ctx.Done():
@@ -64,7 +57,7 @@ func (a *OllamaSentimentAnalyzer) worker() {
 }
 
 // Analyze performs sentiment analysis on the given text
-func (a *OllamaSentimentAnalyzer) Analyze(ctx context.Context, text string, source string) (*SentimentResult, error) {
+func (a *LLMSentimentAnalyzer) Analyze(ctx context.Context, text string, source string) (*SentimentResult, error) {
 	if strings.TrimSpace(text) == "" {
 		return nil, fmt.Errorf("empty text provided")
 	}
@@ -96,7 +89,7 @@ func (a *OllamaSentimentAnalyzer) Analyze(ctx context.Context, text string, sour
 	}
 }
 
-func (a *OllamaSentimentAnalyzer) analyzeSync(text string, source string) (*SentimentResult, error) {
+func (a *LLMSentimentAnalyzer) analyzeSync(text string, source string) (*SentimentResult, error) {
 	prompt := fmt.Sprintf(`Analyze the sentiment of the following text and respond ONLY with a JSON object in this exact format:
 {
   "sentiment": "positive" or "negative" or "neutral",
@@ -113,44 +106,16 @@ Text to analyze: "%s"
 
 JSON response:`, text)
 
-	req := &api.GenerateRequest{
-		Model:  a.model,
-		Prompt: prompt,
-		Stream: new(bool),
-		Options: map[string]any{
-			"temperature": 0.1,
-			"num_predict": 200,
-		},
-	}
-
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	var fullResponse strings.Builder
-	err := a.client.Generate(ctx, req, func(resp api.GenerateResponse) error {
-		fullResponse.WriteString(resp.Response)
-		return nil
-	})
-	if err != nil {
-		return nil, fmt.Errorf("ollama generate error: %w", err)
-	}
-
-	responseText := strings.TrimSpace(fullResponse.String())
-	jsonStart := strings.Index(responseText, "{")
-	jsonEnd := strings.LastIndex(responseText, "}")
-	if jsonStart == -1 || jsonEnd == -1 {
-		return nil, fmt.Errorf("no valid JSON found in response: %s", responseText)
-	}
-	jsonText := responseText[jsonStart : jsonEnd+1]
-
 	var parsed struct {
 		Sentiment string             `json:"sentiment"`
 		Score     float64            `json:"score"`
 		Emotions  map[string]float64 `json:"emotions"`
 	}
-
-	if err := json.Unmarshal([]byte(jsonText), &parsed); err != nil {
-		return nil, fmt.Errorf("failed to parse sentiment response: %w, response: %s", err, jsonText)
+	if err := a.model.CompleteJSON(ctx, &parsed, llm.User(prompt)); err != nil {
+		return nil, fmt.Errorf("failed to analyze sentiment: %w", err)
 	}
 
 	sentiment := strings.ToLower(parsed.Sentiment)
@@ -168,7 +133,7 @@ JSON response:`, text)
 	}, nil
 }
 
-func (a *OllamaSentimentAnalyzer) Close() error {
+func (a *LLMSentimentAnalyzer) Close() error {
 	a.cancel()
 	close(a.analysisChan)
 	return nil
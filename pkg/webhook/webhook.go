@@ -0,0 +1,171 @@
+// Package webhook signs and sends the JSON events ConverSense posts to
+// user-registered endpoints.
+//
+// Every request carries a signature header of the form "t=<unix>,v1=<hex>",
+// where v1 is the HMAC-SHA256 of "<unix>.<body>" keyed with the endpoint
+// secret. Receivers should recompute it and reject stale timestamps.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rahulSailesh-shah/converSense/pkg/safehttp"
+)
+
+// Events sent to webhook endpoints.
+const (
+	EventMeetingStarted   = "meeting.started"
+	EventMeetingCompleted = "meeting.completed"
+	EventSummaryReady     = "summary.ready"
+	EventActionItemsReady = "action_items.ready"
+	EventReminderDue      = "reminder.due"
+)
+
+var validEvents = map[string]bool{
+	EventMeetingStarted:   true,
+	EventMeetingCompleted: true,
+	EventSummaryReady:     true,
+	EventActionItemsReady: true,
+	EventReminderDue:      true,
+}
+
+// Request headers.
+const (
+	HeaderEvent     = "X-ConverSense-Event"
+	HeaderDelivery  = "X-ConverSense-Delivery"
+	HeaderSignature = "X-ConverSense-Signature"
+)
+
+const requestTimeout = 10 * time.Second
+
+// client refuses internal addresses and does not follow redirects: endpoint
+// URLs are user-supplied and a redirect could point anywhere.
+var client = safehttp.NewClient(requestTimeout, false)
+
+// Payload is the body of every webhook request. ID identifies the event and
+// stays the same across retries and replays, so receivers can deduplicate.
+type Payload struct {
+	ID        uuid.UUID `json:"id"`
+	Event     string    `json:"event"`
+	CreatedAt time.Time `json:"createdAt"`
+	Data      any       `json:"data"`
+}
+
+type MeetingData struct {
+	MeetingID uuid.UUID  `json:"meetingId"`
+	OrgID     uuid.UUID  `json:"orgId"`
+	AgentID   uuid.UUID  `json:"agentId"`
+	Name      string     `json:"name"`
+	Status    string     `json:"status"`
+	StartTime *time.Time `json:"startTime,omitempty"`
+	EndTime   *time.Time `json:"endTime,omitempty"`
+}
+
+type SummaryData struct {
+	MeetingID uuid.UUID `json:"meetingId"`
+	OrgID     uuid.UUID `json:"orgId"`
+	Summary   string    `json:"summary"`
+}
+
+type ActionItem struct {
+	ID          uuid.UUID  `json:"id"`
+	Description string     `json:"description"`
+	Owner       *string    `json:"owner,omitempty"`
+	DueDate     *time.Time `json:"dueDate,omitempty"`
+	Status      string     `json:"status"`
+}
+
+type ActionItemsData struct {
+	MeetingID   uuid.UUID    `json:"meetingId"`
+	OrgID       uuid.UUID    `json:"orgId"`
+	ActionItems []ActionItem `json:"actionItems"`
+}
+
+type ReminderData struct {
+	ID        uuid.UUID `json:"id"`
+	MeetingID uuid.UUID `json:"meetingId"`
+	OrgID     uuid.UUID `json:"orgId"`
+	Content   string    `json:"content"`
+	Author    *string   `json:"author,omitempty"`
+	RemindAt  time.Time `json:"remindAt"`
+}
+
+// NewPayload wraps data in a new event of the given type.
+func NewPayload(event string, data any) ([]byte, error) {
+	return json.Marshal(Payload{
+		ID:        uuid.New(),
+		Event:     event,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+}
+
+// ValidateEvents checks that every event is known. An empty list subscribes
+// to all events.
+func ValidateEvents(events []string) error {
+	for _, event := range events {
+		if !validEvents[event] {
+			return fmt.Errorf("unknown webhook event %q", event)
+		}
+	}
+	return nil
+}
+
+// GenerateSecret returns a random endpoint secret.
+func GenerateSecret() (string, error) {
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(raw), nil
+}
+
+// Sign returns the signature header value for body sent at timestamp.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	unix := strconv.FormatInt(timestamp.Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unix + "."))
+	mac.Write(body)
+	return "t=" + unix + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Send posts a signed payload to url and returns the response status. Any
+// non-2xx response, redirects included, is returned as an error.
+func Send(ctx context.Context, url string, secret string, deliveryID uuid.UUID, event string, body []byte) (int, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "ConverSense-Webhooks/1.0")
+	req.Header.Set(HeaderEvent, event)
+	req.Header.Set(HeaderDelivery, deliveryID.String())
+	req.Header.Set(HeaderSignature, Sign(secret, time.Now(), body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint responded with status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}